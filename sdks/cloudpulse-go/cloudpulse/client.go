@@ -3,16 +3,30 @@
 // Usage:
 //
 //	client := cloudpulse.NewClient("cpls_...")
-//	workspaces, err := client.Workspaces.List(nil)
+//	workspaces, _, err := client.Workspaces.List(ctx, nil)
+//
+// Retries, timeouts, and the HTTP transport can be customized via
+// NewClientWithOptions:
+//
+//	client := cloudpulse.NewClientWithOptions("cpls_...",
+//		cloudpulse.WithRetryPolicy(cloudpulse.RetryPolicy{MaxRetries: 5}),
+//		cloudpulse.WithUserAgent("my-app/1.0"),
+//	)
 package cloudpulse
 
 import (
 	"bytes"
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -20,13 +34,19 @@ const (
 	DefaultBaseURL = "https://api.cloudpulse.dev"
 	APIVersion     = "v2"
 	UserAgent      = "cloudpulse-go/0.1.0"
+
+	// IdempotencyKeyHeader is the header callers can set via
+	// WithIdempotencyKey to make a POST request safe to retry.
+	IdempotencyKeyHeader = "Idempotency-Key"
 )
 
 // Client is the CloudPulse API client.
 type Client struct {
-	BaseURL    string
-	APIToken   string
-	HTTPClient *http.Client
+	BaseURL     string
+	APIToken    string
+	HTTPClient  *http.Client
+	UserAgent   string
+	RetryPolicy RetryPolicy
 
 	Workspaces    *WorkspacesService
 	CostReports   *CostReportsService
@@ -34,15 +54,55 @@ type Client struct {
 	Segments      *SegmentsService
 	Teams         *TeamsService
 	VirtualTags   *VirtualTagsService
+	SavedFilters  *SavedFiltersService
+	Dashboards    *DashboardsService
+	Notifications *NotificationsService
+	Bundles       *BundlesService
 	APITokens     *APITokensService
 }
 
-// NewClient creates a new CloudPulse API client.
+// ClientOption configures a Client constructed via NewClientWithOptions.
+type ClientOption func(*Client)
+
+// WithHTTPClient overrides the *http.Client used to send requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) { c.HTTPClient = hc }
+}
+
+// WithBaseURL overrides the API base URL, including the version path
+// (e.g. "https://fixtures.local/api/v2"). Useful for pointing the client
+// at a test fixture server.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) { c.BaseURL = baseURL }
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) { c.UserAgent = userAgent }
+}
+
+// WithRetryPolicy overrides the client's retry policy. See RetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) { c.RetryPolicy = policy }
+}
+
+// NewClient creates a new CloudPulse API client with default options.
 func NewClient(apiToken string) *Client {
+	return NewClientWithOptions(apiToken)
+}
+
+// NewClientWithOptions creates a new CloudPulse API client, applying opts
+// on top of sensible defaults (30s HTTP timeout, DefaultRetryPolicy()).
+func NewClientWithOptions(apiToken string, opts ...ClientOption) *Client {
 	c := &Client{
-		BaseURL:    fmt.Sprintf("%s/api/%s", DefaultBaseURL, APIVersion),
-		APIToken:   apiToken,
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+		BaseURL:     fmt.Sprintf("%s/api/%s", DefaultBaseURL, APIVersion),
+		APIToken:    apiToken,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+		UserAgent:   UserAgent,
+		RetryPolicy: DefaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
 	c.Workspaces = &WorkspacesService{client: c}
 	c.CostReports = &CostReportsService{client: c}
@@ -50,44 +110,341 @@ func NewClient(apiToken string) *Client {
 	c.Segments = &SegmentsService{client: c}
 	c.Teams = &TeamsService{client: c}
 	c.VirtualTags = &VirtualTagsService{client: c}
+	c.SavedFilters = &SavedFiltersService{client: c}
+	c.Dashboards = &DashboardsService{client: c}
+	c.Notifications = &NotificationsService{client: c}
+	c.Bundles = &BundlesService{client: c}
 	c.APITokens = &APITokensService{client: c}
 	return c
 }
 
-func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
-	var buf io.Reader
+// RetryPolicy controls how the client retries transient failures.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retry attempts after the
+	// initial request. A value of 0 disables retries.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry; it doubles on each
+	// subsequent attempt, capped at MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay caps the computed backoff delay before jitter is applied.
+	MaxDelay time.Duration
+	// Jitter, when true, randomizes the backoff delay within [50%, 100%]
+	// of the computed value to avoid thundering-herd retries.
+	Jitter bool
+	// ShouldRetry classifies whether a response status code is worth
+	// retrying for the given HTTP method. It is consulted for every
+	// method; a POST it approves is additionally only retried when the
+	// caller attached an idempotency key via WithIdempotencyKey.
+	ShouldRetry func(method string, statusCode int) bool
+}
+
+// DefaultRetryPolicy retries GET/DELETE/PUT (and idempotency-keyed POST)
+// up to 3 times on 429 and 502/503/504, with exponential backoff from
+// 500ms up to 30s. PATCH is never auto-retried: CloudPulse's PATCH
+// semantics are not guaranteed idempotent and, unlike POST, there is no
+// idempotency-key mechanism to make retrying one safe.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   30 * time.Second,
+		Jitter:     true,
+		ShouldRetry: func(method string, statusCode int) bool {
+			if method == http.MethodPatch {
+				return false
+			}
+			switch statusCode {
+			case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+				return true
+			default:
+				return false
+			}
+		},
+	}
+}
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey attaches an idempotency key to ctx, marking POST
+// requests made with that context as safe to retry under the client's
+// RetryPolicy. The key is sent to the API via the Idempotency-Key header
+// on every request made with that context.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// NewIdempotencyKey returns a freshly generated key suitable for
+// WithIdempotencyKey, formed from 16 bytes of crypto/rand output.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	_, _ = cryptorand.Read(b[:]) // crypto/rand.Read never returns an error
+	return hex.EncodeToString(b[:])
+}
+
+// Response wraps the raw *http.Response returned by the CloudPulse API
+// together with pagination links and rate-limit information, so callers
+// don't need to re-parse headers or the "links" envelope on every call.
+type Response struct {
+	*http.Response
+
+	// Links holds the pagination URLs returned in the response body's
+	// "links" field, if any.
+	Links *Links
+
+	// Rate holds the rate-limit state reported by the API for this
+	// request, if the response included rate-limit headers.
+	Rate Rate
+}
+
+// Links holds the pagination URLs CloudPulse embeds in list responses
+// under the "links" key.
+type Links struct {
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+	Self string `json:"self,omitempty"`
+}
+
+// Rate holds the rate-limit information reported by the CloudPulse API.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// APIError is returned by Client methods whenever the CloudPulse API
+// responds with a status code >= 400. Callers that need to branch on the
+// failure kind (e.g. a Terraform resource's Read deciding whether to
+// remove an item from state) should use errors.As to recover it:
+//
+//	var apiErr *cloudpulse.APIError
+//	if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+//		resp.State.RemoveResource(ctx)
+//		return
+//	}
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the API's machine-readable error code (e.g. "not_found"),
+	// if the error body included one.
+	Code string
+	// Message is the API's human-readable error message.
+	Message string
+	// RequestID is the value of the X-Request-Id response header, if
+	// present, useful when filing support tickets.
+	RequestID string
+	// FieldErrors maps request field names to validation messages, for
+	// 422 responses.
+	FieldErrors map[string]string
+	// Raw is the unparsed response body. It is always populated, and
+	// Error() falls back to it when the body carried no JSON message.
+	Raw []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("cloudpulse: %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("cloudpulse: API error %d: %s", e.StatusCode, string(e.Raw))
+}
+
+// IsNotFound reports whether the API responded 404 Not Found.
+func (e *APIError) IsNotFound() bool { return e.StatusCode == http.StatusNotFound }
+
+// IsConflict reports whether the API responded 409 Conflict.
+func (e *APIError) IsConflict() bool { return e.StatusCode == http.StatusConflict }
+
+// IsRateLimited reports whether the API responded 429 Too Many Requests.
+func (e *APIError) IsRateLimited() bool { return e.StatusCode == http.StatusTooManyRequests }
+
+// IsValidation reports whether the API rejected the request as invalid,
+// either via a 422 status or per-field validation errors.
+func (e *APIError) IsValidation() bool {
+	return e.StatusCode == http.StatusUnprocessableEntity || len(e.FieldErrors) > 0
+}
+
+// parseAPIError builds an *APIError from a failed response, decoding the
+// CloudPulse JSON error envelope ({"code", "message", "errors"}) when
+// possible and falling back to the raw body otherwise.
+func parseAPIError(resp *http.Response, data []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Raw:        data,
+	}
+	var body struct {
+		Code    string            `json:"code"`
+		Message string            `json:"message"`
+		Errors  map[string]string `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &body); err == nil {
+		apiErr.Code = body.Code
+		apiErr.Message = body.Message
+		apiErr.FieldErrors = body.Errors
+	}
+	return apiErr
+}
+
+func newResponse(r *http.Response, links map[string]string) *Response {
+	response := &Response{Response: r}
+	if len(links) > 0 {
+		response.Links = &Links{
+			Next: links["next"],
+			Prev: links["prev"],
+			Self: links["self"],
+		}
+	}
+	response.populateRate()
+	return response
+}
+
+func (r *Response) populateRate() {
+	if limit := r.Header.Get("X-RateLimit-Limit"); limit != "" {
+		r.Rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get("X-RateLimit-Remaining"); remaining != "" {
+		r.Rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get("X-RateLimit-Reset"); reset != "" {
+		if secs, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			r.Rate.Reset = time.Unix(secs, 0)
+		}
+	}
+}
+
+// doRequest issues an HTTP request against the CloudPulse API and, on
+// success, decodes the JSON response body into v (if v is non-nil).
+// Whenever the API sent a response, the last one is returned as a
+// *Response, so callers can inspect pagination links and rate-limit
+// state even when err is non-nil; the *Response is nil if the request
+// failed before any response arrived.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, v interface{}) (*Response, error) {
+	var bodyBytes []byte
 	if body != nil {
 		b, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
-		buf = bytes.NewBuffer(b)
+		bodyBytes = b
 	}
 
-	req, err := http.NewRequest(method, c.BaseURL+path, buf)
-	if err != nil {
-		return nil, err
+	idempotencyKey, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+
+	var resp *Response
+	for attempt := 0; ; attempt++ {
+		var buf io.Reader
+		if bodyBytes != nil {
+			buf = bytes.NewReader(bodyBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, buf)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+c.APIToken)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", c.UserAgent)
+		if idempotencyKey != "" {
+			req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+		}
+
+		httpResp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return resp, err
+		}
+
+		data, err := io.ReadAll(httpResp.Body)
+		httpResp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+
+		var envelope struct {
+			Links map[string]string `json:"links"`
+		}
+		_ = json.Unmarshal(data, &envelope)
+		resp = newResponse(httpResp, envelope.Links)
+
+		if httpResp.StatusCode >= 400 {
+			err := parseAPIError(httpResp, data)
+			if c.shouldRetry(method, httpResp.StatusCode, idempotencyKey) && attempt < c.RetryPolicy.MaxRetries {
+				if waitErr := c.waitForRetry(ctx, resp, attempt); waitErr != nil {
+					return resp, waitErr
+				}
+				continue
+			}
+			return resp, err
+		}
+
+		if v != nil && len(data) > 0 {
+			if err := json.Unmarshal(data, v); err != nil {
+				return resp, err
+			}
+		}
+
+		return resp, nil
 	}
-	req.Header.Set("Authorization", "Bearer "+c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", UserAgent)
+}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+// shouldRetry reports whether a failed request is worth retrying under
+// the client's RetryPolicy. POST is only retried when the caller attached
+// an idempotency key via WithIdempotencyKey, since retrying a POST
+// without one risks creating the resource twice.
+func (c *Client) shouldRetry(method string, statusCode int, idempotencyKey string) bool {
+	if c.RetryPolicy.ShouldRetry == nil || !c.RetryPolicy.ShouldRetry(method, statusCode) {
+		return false
 	}
-	defer resp.Body.Close()
+	if method == http.MethodPost {
+		return idempotencyKey != ""
+	}
+	return true
+}
 
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+// waitForRetry sleeps for the backoff delay before the next retry
+// attempt, honoring the response's Retry-After header when present and
+// returning early if ctx is done before the wait completes.
+func (c *Client) waitForRetry(ctx context.Context, resp *Response, attempt int) error {
+	delay := backoffDelay(c.RetryPolicy, attempt)
+	if retryAfter, ok := retryAfterDelay(resp.Response); ok {
+		delay = retryAfter
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
 	}
+}
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(data))
+// backoffDelay computes the exponential backoff delay for attempt
+// (0-indexed), capped at policy.MaxDelay and optionally jittered.
+func backoffDelay(policy RetryPolicy, attempt int) time.Duration {
+	delay := policy.BaseDelay << attempt
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
 	}
+	if policy.Jitter {
+		delay = time.Duration(float64(delay) * (0.5 + rand.Float64()*0.5))
+	}
+	return delay
+}
 
-	return data, nil
+// retryAfterDelay parses the Retry-After header in either its
+// delay-seconds or HTTP-date form.
+func retryAfterDelay(resp *http.Response) (time.Duration, bool) {
+	h := resp.Header.Get("Retry-After")
+	if h == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(h); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(h); err == nil {
+		if delay := time.Until(t); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
 }
 
 // --- Models ---
@@ -105,14 +462,16 @@ type WorkspaceList struct {
 }
 
 type CostReport struct {
-	Token        string                 `json:"token"`
-	Title        string                 `json:"title"`
-	Filter       string                 `json:"filter,omitempty"`
-	Groupings    string                 `json:"groupings"`
-	DateInterval string                 `json:"date_interval"`
-	DateBucket   string                 `json:"date_bucket"`
-	Settings     map[string]interface{} `json:"settings"`
-	CreatedAt    string                 `json:"created_at"`
+	Token          string                 `json:"token"`
+	WorkspaceToken string                 `json:"workspace_token"`
+	Title          string                 `json:"title"`
+	Filter         string                 `json:"filter,omitempty"`
+	SegmentToken   string                 `json:"segment_token,omitempty"`
+	Groupings      string                 `json:"groupings"`
+	DateInterval   string                 `json:"date_interval"`
+	DateBucket     string                 `json:"date_bucket"`
+	Settings       map[string]interface{} `json:"settings"`
+	CreatedAt      string                 `json:"created_at"`
 }
 
 type CostReportList struct {
@@ -121,9 +480,10 @@ type CostReportList struct {
 }
 
 type Folder struct {
-	Token     string `json:"token"`
-	Title     string `json:"title"`
-	CreatedAt string `json:"created_at"`
+	Token          string `json:"token"`
+	WorkspaceToken string `json:"workspace_token"`
+	Title          string `json:"title"`
+	CreatedAt      string `json:"created_at"`
 }
 
 type FolderList struct {
@@ -133,6 +493,7 @@ type FolderList struct {
 
 type Segment struct {
 	Token            string `json:"token"`
+	WorkspaceToken   string `json:"workspace_token"`
 	Title            string `json:"title"`
 	Description      string `json:"description,omitempty"`
 	Filter           string `json:"filter,omitempty"`
@@ -147,10 +508,11 @@ type SegmentList struct {
 }
 
 type Team struct {
-	Token       string `json:"token"`
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	CreatedAt   string `json:"created_at"`
+	Token          string `json:"token"`
+	WorkspaceToken string `json:"workspace_token"`
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	CreatedAt      string `json:"created_at"`
 }
 
 type TeamList struct {
@@ -159,12 +521,13 @@ type TeamList struct {
 }
 
 type VirtualTag struct {
-	Token       string                   `json:"token"`
-	Key         string                   `json:"key"`
-	Description string                   `json:"description,omitempty"`
-	Overridable bool                     `json:"overridable"`
-	Values      []map[string]interface{} `json:"values"`
-	CreatedAt   string                   `json:"created_at"`
+	Token          string                   `json:"token"`
+	WorkspaceToken string                   `json:"workspace_token"`
+	Key            string                   `json:"key"`
+	Description    string                   `json:"description,omitempty"`
+	Overridable    bool                     `json:"overridable"`
+	Values         []map[string]interface{} `json:"values"`
+	CreatedAt      string                   `json:"created_at"`
 }
 
 type VirtualTagList struct {
@@ -172,6 +535,74 @@ type VirtualTagList struct {
 	Links       map[string]string `json:"links"`
 }
 
+type SavedFilter struct {
+	Token          string `json:"token"`
+	WorkspaceToken string `json:"workspace_token"`
+	Title          string `json:"title"`
+	Filter         string `json:"filter"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type SavedFilterList struct {
+	SavedFilters []SavedFilter     `json:"saved_filters"`
+	Links        map[string]string `json:"links"`
+}
+
+// Notification is a CloudPulse cost-threshold alert. The API never
+// echoes the raw channel target (webhook URL, PagerDuty key, etc.) back
+// in responses; it reports only ChannelTargetHash, a hash of the value
+// on file, so that drift can be detected without storing the secret
+// server-side in plaintext.
+type Notification struct {
+	Token               string `json:"token"`
+	WorkspaceToken      string `json:"workspace_token"`
+	Title               string `json:"title"`
+	ThresholdExpression string `json:"threshold_expression"`
+	DateInterval        string `json:"date_interval"`
+	Groupings           string `json:"groupings,omitempty"`
+	Channel             string `json:"channel"`
+	ChannelTargetHash   string `json:"channel_target_hash"`
+	PayloadTemplate     string `json:"payload_template,omitempty"`
+	SuppressionHours    int    `json:"suppression_hours,omitempty"`
+	CreatedAt           string `json:"created_at"`
+}
+
+type NotificationList struct {
+	Notifications []Notification    `json:"notifications"`
+	Links         map[string]string `json:"links"`
+}
+
+// WorkspaceBundle is the result of an atomic /workspace_bundles creation:
+// a workspace plus every child resource described in the submitted
+// topology, each tagged with the token the server assigned it. Children
+// are returned in the same order they were submitted, which is also
+// their dependency order (e.g. folders before the segments that
+// reference them).
+type WorkspaceBundle struct {
+	WorkspaceToken string        `json:"workspace_token"`
+	Workspace      Workspace     `json:"workspace"`
+	Folders        []Folder      `json:"folders,omitempty"`
+	Segments       []Segment     `json:"segments,omitempty"`
+	VirtualTags    []VirtualTag  `json:"virtual_tags,omitempty"`
+	SavedFilters   []SavedFilter `json:"saved_filters,omitempty"`
+	Dashboards     []Dashboard   `json:"dashboards,omitempty"`
+	CostReports    []CostReport  `json:"cost_reports,omitempty"`
+	CreatedAt      string        `json:"created_at"`
+}
+
+type Dashboard struct {
+	Token          string `json:"token"`
+	WorkspaceToken string `json:"workspace_token"`
+	Title          string `json:"title"`
+	DateInterval   string `json:"date_interval"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type DashboardList struct {
+	Dashboards []Dashboard       `json:"dashboards"`
+	Links      map[string]string `json:"links"`
+}
+
 type APITokenResponse struct {
 	TokenPrefix string `json:"token_prefix"`
 	Name        string `json:"name"`
@@ -185,6 +616,10 @@ type APITokenCreated struct {
 	Token string `json:"token"`
 }
 
+type APITokenList struct {
+	APITokens []APITokenResponse `json:"api_tokens"`
+}
+
 type Message struct {
 	Message string `json:"message"`
 }
@@ -214,185 +649,590 @@ func (p *ListParams) toQuery() string {
 	return ""
 }
 
+// pathFromURL reduces an absolute "links" URL down to the path (plus
+// query string) that doRequest expects, since those URLs are already
+// anchored at the client's BaseURL.
+func (c *Client) pathFromURL(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	if u, err := url.Parse(rawURL); err == nil && u.IsAbs() {
+		path := strings.TrimPrefix(u.Path, strings.TrimPrefix(c.BaseURL, u.Scheme+"://"+u.Host))
+		if u.RawQuery != "" {
+			path += "?" + u.RawQuery
+		}
+		return path
+	}
+	return rawURL
+}
+
 // --- Services ---
 
 type WorkspacesService struct{ client *Client }
 
-func (s *WorkspacesService) List(params *ListParams) (*WorkspaceList, error) {
-	data, err := s.client.doRequest("GET", "/workspaces"+params.toQuery(), nil)
-	if err != nil { return nil, err }
+func (s *WorkspacesService) List(ctx context.Context, params *ListParams) (*WorkspaceList, *Response, error) {
 	var result WorkspaceList
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/workspaces"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+// ListWorkspaces walks every page of Workspaces.List starting from params,
+// following the "next" pagination link until the API reports no more
+// pages, and returns the concatenated result.
+func (s *WorkspacesService) ListWorkspaces(ctx context.Context, params *ListParams) ([]Workspace, error) {
+	var all []Workspace
+	page, resp, err := s.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		all = append(all, page.Workspaces...)
+		if resp.Links == nil || resp.Links.Next == "" {
+			return all, nil
+		}
+		page = &WorkspaceList{}
+		resp, err = s.client.doRequest(ctx, "GET", s.client.pathFromURL(resp.Links.Next), nil, page)
+		if err != nil {
+			return nil, err
+		}
+	}
 }
 
-func (s *WorkspacesService) Get(token string) (*Workspace, error) {
-	data, err := s.client.doRequest("GET", "/workspaces/"+token, nil)
-	if err != nil { return nil, err }
+func (s *WorkspacesService) Get(ctx context.Context, token string) (*Workspace, *Response, error) {
 	var result Workspace
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/workspaces/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *WorkspacesService) Create(body map[string]interface{}) (*Workspace, error) {
-	data, err := s.client.doRequest("POST", "/workspaces", body)
-	if err != nil { return nil, err }
+func (s *WorkspacesService) Create(ctx context.Context, body map[string]interface{}) (*Workspace, *Response, error) {
 	var result Workspace
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "POST", "/workspaces", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *WorkspacesService) Update(ctx context.Context, token string, body map[string]interface{}) (*Workspace, *Response, error) {
+	var result Workspace
+	resp, err := s.client.doRequest(ctx, "PATCH", "/workspaces/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *WorkspacesService) Delete(token string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/workspaces/"+token, nil)
-	if err != nil { return nil, err }
+func (s *WorkspacesService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/workspaces/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
 type CostReportsService struct{ client *Client }
 
-func (s *CostReportsService) List(params *ListParams) (*CostReportList, error) {
-	data, err := s.client.doRequest("GET", "/cost_reports"+params.toQuery(), nil)
-	if err != nil { return nil, err }
+func (s *CostReportsService) List(ctx context.Context, params *ListParams) (*CostReportList, *Response, error) {
 	var result CostReportList
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/cost_reports"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+// ListCostReports walks every page of CostReports.List starting from
+// params, following the "next" pagination link until the API reports no
+// more pages, and returns the concatenated result.
+func (s *CostReportsService) ListCostReports(ctx context.Context, params *ListParams) ([]CostReport, error) {
+	var all []CostReport
+	page, resp, err := s.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		all = append(all, page.CostReports...)
+		if resp.Links == nil || resp.Links.Next == "" {
+			return all, nil
+		}
+		page = &CostReportList{}
+		resp, err = s.client.doRequest(ctx, "GET", s.client.pathFromURL(resp.Links.Next), nil, page)
+		if err != nil {
+			return nil, err
+		}
+	}
 }
 
-func (s *CostReportsService) Get(token string) (*CostReport, error) {
-	data, err := s.client.doRequest("GET", "/cost_reports/"+token, nil)
-	if err != nil { return nil, err }
+func (s *CostReportsService) Get(ctx context.Context, token string) (*CostReport, *Response, error) {
 	var result CostReport
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/cost_reports/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *CostReportsService) Create(ctx context.Context, body map[string]interface{}) (*CostReport, *Response, error) {
+	var result CostReport
+	resp, err := s.client.doRequest(ctx, "POST", "/cost_reports", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *CostReportsService) Create(body map[string]interface{}) (*CostReport, error) {
-	data, err := s.client.doRequest("POST", "/cost_reports", body)
-	if err != nil { return nil, err }
+func (s *CostReportsService) Update(ctx context.Context, token string, body map[string]interface{}) (*CostReport, *Response, error) {
 	var result CostReport
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "PATCH", "/cost_reports/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *CostReportsService) Delete(token string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/cost_reports/"+token, nil)
-	if err != nil { return nil, err }
+func (s *CostReportsService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/cost_reports/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
 type FoldersService struct{ client *Client }
 
-func (s *FoldersService) List(params *ListParams) (*FolderList, error) {
-	data, err := s.client.doRequest("GET", "/folders"+params.toQuery(), nil)
-	if err != nil { return nil, err }
+func (s *FoldersService) List(ctx context.Context, params *ListParams) (*FolderList, *Response, error) {
 	var result FolderList
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/folders"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+// ListFolders walks every page of Folders.List starting from params,
+// following the "next" pagination link until the API reports no more
+// pages, and returns the concatenated result.
+func (s *FoldersService) ListFolders(ctx context.Context, params *ListParams) ([]Folder, error) {
+	var all []Folder
+	page, resp, err := s.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		all = append(all, page.Folders...)
+		if resp.Links == nil || resp.Links.Next == "" {
+			return all, nil
+		}
+		page = &FolderList{}
+		resp, err = s.client.doRequest(ctx, "GET", s.client.pathFromURL(resp.Links.Next), nil, page)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *FoldersService) Get(ctx context.Context, token string) (*Folder, *Response, error) {
+	var result Folder
+	resp, err := s.client.doRequest(ctx, "GET", "/folders/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *FoldersService) Create(ctx context.Context, body map[string]interface{}) (*Folder, *Response, error) {
+	var result Folder
+	resp, err := s.client.doRequest(ctx, "POST", "/folders", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *FoldersService) Create(body map[string]interface{}) (*Folder, error) {
-	data, err := s.client.doRequest("POST", "/folders", body)
-	if err != nil { return nil, err }
+func (s *FoldersService) Update(ctx context.Context, token string, body map[string]interface{}) (*Folder, *Response, error) {
 	var result Folder
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "PATCH", "/folders/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *FoldersService) Delete(token string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/folders/"+token, nil)
-	if err != nil { return nil, err }
+func (s *FoldersService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/folders/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
 type SegmentsService struct{ client *Client }
 
-func (s *SegmentsService) List(params *ListParams) (*SegmentList, error) {
-	data, err := s.client.doRequest("GET", "/segments"+params.toQuery(), nil)
-	if err != nil { return nil, err }
+func (s *SegmentsService) List(ctx context.Context, params *ListParams) (*SegmentList, *Response, error) {
 	var result SegmentList
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/segments"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *SegmentsService) Create(body map[string]interface{}) (*Segment, error) {
-	data, err := s.client.doRequest("POST", "/segments", body)
-	if err != nil { return nil, err }
+func (s *SegmentsService) Get(ctx context.Context, token string) (*Segment, *Response, error) {
 	var result Segment
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/segments/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *SegmentsService) Delete(token string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/segments/"+token, nil)
-	if err != nil { return nil, err }
+func (s *SegmentsService) Create(ctx context.Context, body map[string]interface{}) (*Segment, *Response, error) {
+	var result Segment
+	resp, err := s.client.doRequest(ctx, "POST", "/segments", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *SegmentsService) Update(ctx context.Context, token string, body map[string]interface{}) (*Segment, *Response, error) {
+	var result Segment
+	resp, err := s.client.doRequest(ctx, "PATCH", "/segments/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *SegmentsService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/segments/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
 type TeamsService struct{ client *Client }
 
-func (s *TeamsService) List(params *ListParams) (*TeamList, error) {
-	data, err := s.client.doRequest("GET", "/teams"+params.toQuery(), nil)
-	if err != nil { return nil, err }
+func (s *TeamsService) List(ctx context.Context, params *ListParams) (*TeamList, *Response, error) {
 	var result TeamList
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/teams"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *TeamsService) Create(body map[string]interface{}) (*Team, error) {
-	data, err := s.client.doRequest("POST", "/teams", body)
-	if err != nil { return nil, err }
+func (s *TeamsService) Get(ctx context.Context, token string) (*Team, *Response, error) {
 	var result Team
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/teams/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *TeamsService) Delete(token string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/teams/"+token, nil)
-	if err != nil { return nil, err }
+func (s *TeamsService) Create(ctx context.Context, body map[string]interface{}) (*Team, *Response, error) {
+	var result Team
+	resp, err := s.client.doRequest(ctx, "POST", "/teams", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *TeamsService) Update(ctx context.Context, token string, body map[string]interface{}) (*Team, *Response, error) {
+	var result Team
+	resp, err := s.client.doRequest(ctx, "PATCH", "/teams/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *TeamsService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/teams/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
 type VirtualTagsService struct{ client *Client }
 
-func (s *VirtualTagsService) List(params *ListParams) (*VirtualTagList, error) {
-	data, err := s.client.doRequest("GET", "/virtual_tags"+params.toQuery(), nil)
-	if err != nil { return nil, err }
+func (s *VirtualTagsService) List(ctx context.Context, params *ListParams) (*VirtualTagList, *Response, error) {
 	var result VirtualTagList
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/virtual_tags"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *VirtualTagsService) Create(ctx context.Context, body map[string]interface{}) (*VirtualTag, *Response, error) {
+	var result VirtualTag
+	resp, err := s.client.doRequest(ctx, "POST", "/virtual_tags", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *VirtualTagsService) Create(body map[string]interface{}) (*VirtualTag, error) {
-	data, err := s.client.doRequest("POST", "/virtual_tags", body)
-	if err != nil { return nil, err }
+func (s *VirtualTagsService) Get(ctx context.Context, token string) (*VirtualTag, *Response, error) {
 	var result VirtualTag
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "GET", "/virtual_tags/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *VirtualTagsService) Update(ctx context.Context, token string, body map[string]interface{}) (*VirtualTag, *Response, error) {
+	var result VirtualTag
+	resp, err := s.client.doRequest(ctx, "PATCH", "/virtual_tags/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *VirtualTagsService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
+	var result Message
+	resp, err := s.client.doRequest(ctx, "DELETE", "/virtual_tags/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+type SavedFiltersService struct{ client *Client }
+
+func (s *SavedFiltersService) List(ctx context.Context, params *ListParams) (*SavedFilterList, *Response, error) {
+	var result SavedFilterList
+	resp, err := s.client.doRequest(ctx, "GET", "/saved_filters"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *SavedFiltersService) Get(ctx context.Context, token string) (*SavedFilter, *Response, error) {
+	var result SavedFilter
+	resp, err := s.client.doRequest(ctx, "GET", "/saved_filters/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *SavedFiltersService) Create(ctx context.Context, body map[string]interface{}) (*SavedFilter, *Response, error) {
+	var result SavedFilter
+	resp, err := s.client.doRequest(ctx, "POST", "/saved_filters", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *SavedFiltersService) Update(ctx context.Context, token string, body map[string]interface{}) (*SavedFilter, *Response, error) {
+	var result SavedFilter
+	resp, err := s.client.doRequest(ctx, "PATCH", "/saved_filters/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *SavedFiltersService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
+	var result Message
+	resp, err := s.client.doRequest(ctx, "DELETE", "/saved_filters/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+type DashboardsService struct{ client *Client }
+
+func (s *DashboardsService) List(ctx context.Context, params *ListParams) (*DashboardList, *Response, error) {
+	var result DashboardList
+	resp, err := s.client.doRequest(ctx, "GET", "/dashboards"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *DashboardsService) Get(ctx context.Context, token string) (*Dashboard, *Response, error) {
+	var result Dashboard
+	resp, err := s.client.doRequest(ctx, "GET", "/dashboards/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *DashboardsService) Create(ctx context.Context, body map[string]interface{}) (*Dashboard, *Response, error) {
+	var result Dashboard
+	resp, err := s.client.doRequest(ctx, "POST", "/dashboards", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *DashboardsService) Update(ctx context.Context, token string, body map[string]interface{}) (*Dashboard, *Response, error) {
+	var result Dashboard
+	resp, err := s.client.doRequest(ctx, "PATCH", "/dashboards/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *DashboardsService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
+	var result Message
+	resp, err := s.client.doRequest(ctx, "DELETE", "/dashboards/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+type NotificationsService struct{ client *Client }
+
+func (s *NotificationsService) List(ctx context.Context, params *ListParams) (*NotificationList, *Response, error) {
+	var result NotificationList
+	resp, err := s.client.doRequest(ctx, "GET", "/notifications"+params.toQuery(), nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *NotificationsService) Get(ctx context.Context, token string) (*Notification, *Response, error) {
+	var result Notification
+	resp, err := s.client.doRequest(ctx, "GET", "/notifications/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *NotificationsService) Create(ctx context.Context, body map[string]interface{}) (*Notification, *Response, error) {
+	var result Notification
+	resp, err := s.client.doRequest(ctx, "POST", "/notifications", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *NotificationsService) Update(ctx context.Context, token string, body map[string]interface{}) (*Notification, *Response, error) {
+	var result Notification
+	resp, err := s.client.doRequest(ctx, "PATCH", "/notifications/"+token, body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *NotificationsService) Delete(ctx context.Context, token string) (*Message, *Response, error) {
+	var result Message
+	resp, err := s.client.doRequest(ctx, "DELETE", "/notifications/"+token, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+// Test issues a dry run of the given notification configuration, verifying
+// the delivery channel's credentials (e.g. that a webhook URL is reachable
+// or a PagerDuty service key is valid) without persisting anything. Pass
+// the token of an existing notification to re-verify it, or omit it to
+// dry-run a configuration that hasn't been created yet.
+func (s *NotificationsService) Test(ctx context.Context, body map[string]interface{}) (*Message, *Response, error) {
+	var result Message
+	resp, err := s.client.doRequest(ctx, "POST", "/notifications/test", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+type BundlesService struct{ client *Client }
+
+// Create submits an entire workspace topology (a workspace plus any
+// combination of folders, segments, virtual tags, saved filters,
+// dashboards, and cost reports) as a single document. The server creates
+// everything atomically, rolling back the whole bundle if any child
+// fails, and returns every resulting token in dependency order.
+func (s *BundlesService) Create(ctx context.Context, body map[string]interface{}) (*WorkspaceBundle, *Response, error) {
+	var result WorkspaceBundle
+	resp, err := s.client.doRequest(ctx, "POST", "/workspace_bundles", body, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
+}
+
+func (s *BundlesService) Get(ctx context.Context, workspaceToken string) (*WorkspaceBundle, *Response, error) {
+	var result WorkspaceBundle
+	resp, err := s.client.doRequest(ctx, "GET", "/workspace_bundles/"+workspaceToken, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *VirtualTagsService) Delete(token string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/virtual_tags/"+token, nil)
-	if err != nil { return nil, err }
+// Delete tears down a bundle's workspace and every child it created.
+func (s *BundlesService) Delete(ctx context.Context, workspaceToken string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/workspace_bundles/"+workspaceToken, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
 type APITokensService struct{ client *Client }
 
-func (s *APITokensService) List() (*struct {
-	APITokens []APITokenResponse `json:"api_tokens"`
-}, error) {
-	data, err := s.client.doRequest("GET", "/api_tokens", nil)
-	if err != nil { return nil, err }
-	var result struct {
-		APITokens []APITokenResponse `json:"api_tokens"`
+func (s *APITokensService) List(ctx context.Context) (*APITokenList, *Response, error) {
+	var result APITokenList
+	resp, err := s.client.doRequest(ctx, "GET", "/api_tokens", nil, &result)
+	if err != nil {
+		return nil, resp, err
 	}
-	return &result, json.Unmarshal(data, &result)
+	return &result, resp, nil
 }
 
-func (s *APITokensService) Create(name, scopes string) (*APITokenCreated, error) {
-	data, err := s.client.doRequest("POST", "/api_tokens", map[string]interface{}{
-		"name": name, "scopes": scopes,
-	})
-	if err != nil { return nil, err }
+func (s *APITokensService) Create(ctx context.Context, name, scopes string) (*APITokenCreated, *Response, error) {
 	var result APITokenCreated
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "POST", "/api_tokens", map[string]interface{}{
+		"name": name, "scopes": scopes,
+	}, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
 
-func (s *APITokensService) Revoke(tokenPrefix string) (*Message, error) {
-	data, err := s.client.doRequest("DELETE", "/api_tokens/"+tokenPrefix, nil)
-	if err != nil { return nil, err }
+func (s *APITokensService) Revoke(ctx context.Context, tokenPrefix string) (*Message, *Response, error) {
 	var result Message
-	return &result, json.Unmarshal(data, &result)
+	resp, err := s.client.doRequest(ctx, "DELETE", "/api_tokens/"+tokenPrefix, nil, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &result, resp, nil
 }
@@ -0,0 +1,338 @@
+package cloudpulse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper, so tests can stub
+// transport behavior without spinning up a real listener.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func testClient(t *testing.T, rt roundTripFunc, opts ...ClientOption) *Client {
+	t.Helper()
+	allOpts := append([]ClientOption{
+		WithHTTPClient(&http.Client{Transport: rt}),
+		WithBaseURL("https://fixtures.local/api/v2"),
+	}, opts...)
+	return NewClientWithOptions("test-token", allOpts...)
+}
+
+func TestDoRequest_RetriesOnServiceUnavailableThenSucceeds(t *testing.T) {
+	var attempts int32
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		if n < 3 {
+			return newTestResponse(http.StatusServiceUnavailable, `{"code":"unavailable","message":"try again"}`, nil), nil
+		}
+		return newTestResponse(http.StatusOK, `{"token":"ws_1","name":"prod"}`, nil), nil
+	}, WithRetryPolicy(RetryPolicy{
+		MaxRetries:  3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+		Jitter:      false,
+		ShouldRetry: DefaultRetryPolicy().ShouldRetry,
+	}))
+
+	ws, _, err := client.Workspaces.Get(context.Background(), "ws_1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if ws.Token != "ws_1" {
+		t.Fatalf("got token %q, want ws_1", ws.Token)
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts, want 3", attempts)
+	}
+}
+
+func TestDoRequest_PostNotRetriedWithoutIdempotencyKey(t *testing.T) {
+	var attempts int32
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return newTestResponse(http.StatusServiceUnavailable, `{"code":"unavailable"}`, nil), nil
+	}, WithRetryPolicy(RetryPolicy{
+		MaxRetries:  3,
+		BaseDelay:   time.Millisecond,
+		Jitter:      false,
+		ShouldRetry: DefaultRetryPolicy().ShouldRetry,
+	}))
+
+	_, _, err := client.Workspaces.Create(context.Background(), map[string]interface{}{"name": "prod"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if attempts != 1 {
+		t.Fatalf("got %d attempts, want 1 (POST without idempotency key must not retry)", attempts)
+	}
+}
+
+func TestDoRequest_PatchNotRetried(t *testing.T) {
+	var attempts int32
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return newTestResponse(http.StatusServiceUnavailable, `{"code":"unavailable"}`, nil), nil
+	}, WithRetryPolicy(RetryPolicy{
+		MaxRetries:  3,
+		BaseDelay:   time.Millisecond,
+		Jitter:      false,
+		ShouldRetry: DefaultRetryPolicy().ShouldRetry,
+	}))
+
+	_, _, err := client.Workspaces.Update(context.Background(), "ws_1", map[string]interface{}{"name": "prod"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if attempts != 1 {
+		t.Fatalf("got %d attempts, want 1 (PATCH must not be auto-retried, even on a retryable status)", attempts)
+	}
+}
+
+func TestDoRequest_PostRetriedWithIdempotencyKey(t *testing.T) {
+	var attempts int32
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&attempts, 1)
+		if got := req.Header.Get(IdempotencyKeyHeader); got != "fixed-key" {
+			t.Errorf("Idempotency-Key header = %q, want fixed-key", got)
+		}
+		if n < 2 {
+			return newTestResponse(http.StatusServiceUnavailable, `{"code":"unavailable"}`, nil), nil
+		}
+		return newTestResponse(http.StatusOK, `{"token":"ws_1","name":"prod"}`, nil), nil
+	}, WithRetryPolicy(RetryPolicy{
+		MaxRetries:  3,
+		BaseDelay:   time.Millisecond,
+		Jitter:      false,
+		ShouldRetry: DefaultRetryPolicy().ShouldRetry,
+	}))
+
+	ctx := WithIdempotencyKey(context.Background(), "fixed-key")
+	ws, _, err := client.Workspaces.Create(ctx, map[string]interface{}{"name": "prod"})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if ws.Token != "ws_1" {
+		t.Fatalf("got token %q, want ws_1", ws.Token)
+	}
+	if attempts != 2 {
+		t.Fatalf("got %d attempts, want 2", attempts)
+	}
+}
+
+func TestDoRequest_StopsRetryingAfterMaxRetries(t *testing.T) {
+	var attempts int32
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return newTestResponse(http.StatusServiceUnavailable, `{"code":"unavailable"}`, nil), nil
+	}, WithRetryPolicy(RetryPolicy{
+		MaxRetries:  2,
+		BaseDelay:   time.Millisecond,
+		Jitter:      false,
+		ShouldRetry: DefaultRetryPolicy().ShouldRetry,
+	}))
+
+	_, _, err := client.Workspaces.Get(context.Background(), "ws_1")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if attempts != 3 { // initial attempt + 2 retries
+		t.Fatalf("got %d attempts, want 3", attempts)
+	}
+}
+
+func TestDoRequest_ReturnsTypedAPIError(t *testing.T) {
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		resp := newTestResponse(http.StatusNotFound, `{"code":"not_found","message":"no such workspace"}`, nil)
+		resp.Header.Set("X-Request-Id", "req_123")
+		return resp, nil
+	})
+
+	_, _, err := client.Workspaces.Get(context.Background(), "ws_missing")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if !apiErr.IsNotFound() {
+		t.Fatalf("expected IsNotFound, got status %d", apiErr.StatusCode)
+	}
+	if apiErr.IsConflict() || apiErr.IsRateLimited() || apiErr.IsValidation() {
+		t.Fatalf("unexpected kind classification on %+v", apiErr)
+	}
+	if apiErr.RequestID != "req_123" {
+		t.Fatalf("got RequestID %q, want req_123", apiErr.RequestID)
+	}
+	if apiErr.Message != "no such workspace" {
+		t.Fatalf("got Message %q, want %q", apiErr.Message, "no such workspace")
+	}
+}
+
+func TestDoRequest_ValidationErrorHasFieldErrors(t *testing.T) {
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(http.StatusUnprocessableEntity, `{"code":"invalid","message":"invalid request","errors":{"name":"is required"}}`, nil), nil
+	})
+
+	_, _, err := client.Workspaces.Get(context.Background(), "ws_1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if !apiErr.IsValidation() {
+		t.Fatal("expected IsValidation to be true")
+	}
+	if apiErr.FieldErrors["name"] != "is required" {
+		t.Fatalf("got FieldErrors[name] = %q, want %q", apiErr.FieldErrors["name"], "is required")
+	}
+}
+
+func TestWaitForRetry_HonorsRetryAfterSeconds(t *testing.T) {
+	c := &Client{RetryPolicy: RetryPolicy{BaseDelay: time.Hour}} // huge backoff so the test would hang if Retry-After were ignored
+	resp := newResponse(&http.Response{Header: make(http.Header)}, nil)
+	resp.Header.Set("Retry-After", "0")
+
+	done := make(chan error, 1)
+	go func() { done <- c.waitForRetry(context.Background(), resp, 0) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("waitForRetry returned error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waitForRetry did not honor Retry-After: 0 and used the backoff delay instead")
+	}
+}
+
+func TestWaitForRetry_HonorsRetryAfterHTTPDate(t *testing.T) {
+	c := &Client{RetryPolicy: RetryPolicy{BaseDelay: time.Hour}}
+	resp := newResponse(&http.Response{Header: make(http.Header)}, nil)
+	resp.Header.Set("Retry-After", time.Now().Add(10*time.Millisecond).UTC().Format(http.TimeFormat))
+
+	start := time.Now()
+	if err := c.waitForRetry(context.Background(), resp, 0); err != nil {
+		t.Fatalf("waitForRetry returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("waitForRetry took %v, want well under 1s", elapsed)
+	}
+}
+
+func TestWaitForRetry_ReturnsContextErrorWhenCanceled(t *testing.T) {
+	c := &Client{RetryPolicy: RetryPolicy{BaseDelay: time.Hour}}
+	resp := newResponse(&http.Response{Header: make(http.Header)}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := c.waitForRetry(ctx, resp, 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+}
+
+func TestBackoffDelay_DoublesAndCapsAtMaxDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond, Jitter: false}
+	if got := backoffDelay(policy, 0); got != 100*time.Millisecond {
+		t.Errorf("attempt 0: got %v, want 100ms", got)
+	}
+	if got := backoffDelay(policy, 1); got != 200*time.Millisecond {
+		t.Errorf("attempt 1: got %v, want 200ms", got)
+	}
+	if got := backoffDelay(policy, 2); got != 300*time.Millisecond {
+		t.Errorf("attempt 2: got %v, want 300ms (capped)", got)
+	}
+}
+
+func TestRetryAfterDelay_ParsesSecondsAndDate(t *testing.T) {
+	resp := &http.Response{Header: make(http.Header)}
+
+	resp.Header.Set("Retry-After", "5")
+	delay, ok := retryAfterDelay(resp)
+	if !ok || delay != 5*time.Second {
+		t.Fatalf("got (%v, %v), want (5s, true)", delay, ok)
+	}
+
+	future := time.Now().Add(30 * time.Second)
+	resp.Header.Set("Retry-After", future.UTC().Format(http.TimeFormat))
+	delay, ok = retryAfterDelay(resp)
+	if !ok || delay <= 0 || delay > 30*time.Second {
+		t.Fatalf("got (%v, %v), want a positive delay <= 30s", delay, ok)
+	}
+
+	resp.Header.Set("Retry-After", "not-a-valid-value")
+	if _, ok := retryAfterDelay(resp); ok {
+		t.Fatal("expected ok=false for an unparseable Retry-After value")
+	}
+
+	resp.Header.Del("Retry-After")
+	if _, ok := retryAfterDelay(resp); ok {
+		t.Fatal("expected ok=false when Retry-After is absent")
+	}
+}
+
+func TestPathFromURL_StripsBaseURLPrefix(t *testing.T) {
+	c := &Client{BaseURL: "https://fixtures.local/api/v2"}
+
+	got := c.pathFromURL("https://fixtures.local/api/v2/workspaces?page=2&limit=50")
+	if want := "/workspaces?page=2&limit=50"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got := c.pathFromURL(""); got != "" {
+		t.Errorf("got %q, want empty string", got)
+	}
+
+	if got := c.pathFromURL("/already/a/path"); got != "/already/a/path" {
+		t.Errorf("got %q, want unchanged relative path", got)
+	}
+}
+
+func TestListWorkspaces_FollowsPaginationLinks(t *testing.T) {
+	var requestedPaths []string
+	client := testClient(t, func(req *http.Request) (*http.Response, error) {
+		requestedPaths = append(requestedPaths, req.URL.RequestURI())
+		switch len(requestedPaths) {
+		case 1:
+			return newTestResponse(http.StatusOK, `{"workspaces":[{"token":"ws_1"}],"links":{"next":"https://fixtures.local/api/v2/workspaces?page=2"}}`, nil), nil
+		case 2:
+			return newTestResponse(http.StatusOK, `{"workspaces":[{"token":"ws_2"}],"links":{}}`, nil), nil
+		default:
+			t.Fatalf("unexpected extra request: %v", requestedPaths)
+			return nil, nil
+		}
+	})
+
+	all, err := client.Workspaces.ListWorkspaces(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListWorkspaces returned error: %v", err)
+	}
+	if len(all) != 2 || all[0].Token != "ws_1" || all[1].Token != "ws_2" {
+		t.Fatalf("got %+v, want ws_1 then ws_2", all)
+	}
+	if len(requestedPaths) != 2 || requestedPaths[1] != "/api/v2/workspaces?page=2" {
+		t.Fatalf("got requested paths %v", requestedPaths)
+	}
+}
+
+// newTestResponse builds an *http.Response carrying body as its JSON
+// payload, for use as a roundTripFunc return value. extraHeaders may be
+// nil.
+func newTestResponse(status int, body string, extraHeaders http.Header) *http.Response {
+	rec := httptest.NewRecorder()
+	rec.Code = status
+	rec.Body.WriteString(body)
+	resp := rec.Result()
+	for k, vs := range extraHeaders {
+		for _, v := range vs {
+			resp.Header.Add(k, v)
+		}
+	}
+	return resp
+}
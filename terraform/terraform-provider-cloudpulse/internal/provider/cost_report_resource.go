@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                     = &costReportResource{}
+	_ resource.ResourceWithConfigure        = &costReportResource{}
+	_ resource.ResourceWithConfigValidators = &costReportResource{}
+	_ resource.ResourceWithImportState      = &costReportResource{}
+)
+
+func NewCostReportResource() resource.Resource {
+	return &costReportResource{}
+}
+
+type costReportResource struct {
+	client *cloudpulse.Client
+}
+
+type costReportResourceModel struct {
+	Token          types.String `tfsdk:"token"`
+	WorkspaceToken types.String `tfsdk:"workspace_token"`
+	Title          types.String `tfsdk:"title"`
+	Filter         types.String `tfsdk:"filter"`
+	SegmentToken   types.String `tfsdk:"segment_token"`
+	Groupings      types.String `tfsdk:"groupings"`
+	DateInterval   types.String `tfsdk:"date_interval"`
+	DateBucket     types.String `tfsdk:"date_bucket"`
+	SettingsJSON   types.String `tfsdk:"settings_json"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *costReportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cost_report"
+}
+
+func (r *costReportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse cost report.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the cost report.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this cost report belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Display title of the cost report.",
+				Required:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "CloudPulse filter expression, e.g. \"costs.service = 'Amazon EC2'\". Conflicts with segment_token.",
+				Optional:    true,
+			},
+			"segment_token": schema.StringAttribute{
+				Description: "Token of a cloudpulse_segment to scope this report to, instead of an ad hoc filter. Conflicts with filter.",
+				Optional:    true,
+			},
+			"groupings": schema.StringAttribute{
+				Description: "Comma-separated dimensions to group by, e.g. \"service\".",
+				Required:    true,
+			},
+			"date_interval": schema.StringAttribute{
+				Description: "Date range for the report, e.g. \"last_30_days\".",
+				Required:    true,
+			},
+			"date_bucket": schema.StringAttribute{
+				Description: "Bucket size for the report's time series, e.g. \"daily\" or \"monthly\".",
+				Optional:    true,
+			},
+			"settings_json": schema.StringAttribute{
+				Description: "Additional report settings (chart type, currency, etc.) as a JSON object.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the cost report was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *costReportResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("filter"),
+			path.MatchRoot("segment_token"),
+		),
+	}
+}
+
+func (r *costReportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *costReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan costReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := costReportRequestBody(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	costReport, _, err := r.client.CostReports.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Cost Report", err.Error())
+		return
+	}
+
+	model, diags := costReportModelFromAPI(costReport, plan.SettingsJSON)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *costReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state costReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	costReport, _, err := r.client.CostReports.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Cost Report", err.Error())
+		return
+	}
+
+	model, diags := costReportModelFromAPI(costReport, state.SettingsJSON)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *costReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state costReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := costReportRequestBody(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	costReport, _, err := r.client.CostReports.Update(ctx, state.Token.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Cost Report", err.Error())
+		return
+	}
+
+	model, diags := costReportModelFromAPI(costReport, plan.SettingsJSON)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *costReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state costReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.CostReports.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Cost Report", err.Error())
+	}
+}
+
+func (r *costReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func costReportRequestBody(plan costReportResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body := map[string]interface{}{
+		"workspace_token": plan.WorkspaceToken.ValueString(),
+		"title":           plan.Title.ValueString(),
+		"filter":          plan.Filter.ValueString(),
+		"segment_token":   plan.SegmentToken.ValueString(),
+		"groupings":       plan.Groupings.ValueString(),
+		"date_interval":   plan.DateInterval.ValueString(),
+		"date_bucket":     plan.DateBucket.ValueString(),
+	}
+	if !plan.SettingsJSON.IsNull() && plan.SettingsJSON.ValueString() != "" {
+		var settings map[string]interface{}
+		if err := json.Unmarshal([]byte(plan.SettingsJSON.ValueString()), &settings); err != nil {
+			diags.AddAttributeError(path.Root("settings_json"), "Invalid Settings JSON", err.Error())
+			return nil, diags
+		}
+		body["settings"] = settings
+	}
+	return body, diags
+}
+
+func costReportModelFromAPI(c *cloudpulse.CostReport, settingsJSON types.String) (costReportResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := costReportResourceModel{
+		Token:          types.StringValue(c.Token),
+		WorkspaceToken: types.StringValue(c.WorkspaceToken),
+		Title:          types.StringValue(c.Title),
+		Filter:         types.StringValue(c.Filter),
+		SegmentToken:   types.StringValue(c.SegmentToken),
+		Groupings:      types.StringValue(c.Groupings),
+		DateInterval:   types.StringValue(c.DateInterval),
+		DateBucket:     types.StringValue(c.DateBucket),
+		SettingsJSON:   settingsJSON,
+		CreatedAt:      types.StringValue(c.CreatedAt),
+	}
+	if len(c.Settings) > 0 {
+		b, err := json.Marshal(c.Settings)
+		if err != nil {
+			diags.AddError("Error Encoding Cost Report Settings", err.Error())
+			return model, diags
+		}
+		model.SettingsJSON = types.StringValue(string(b))
+	}
+	return model, diags
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ datasource.DataSource              = &costReportsDataSource{}
+	_ datasource.DataSourceWithConfigure = &costReportsDataSource{}
+)
+
+func NewCostReportsDataSource() datasource.DataSource {
+	return &costReportsDataSource{}
+}
+
+type costReportsDataSource struct {
+	client *cloudpulse.Client
+}
+
+type costReportsDataSourceModel struct {
+	WorkspaceToken types.String              `tfsdk:"workspace_token"`
+	CostReports    []costReportResourceModel `tfsdk:"cost_reports"`
+}
+
+func (d *costReportsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cost_reports"
+}
+
+func (d *costReportsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists CloudPulse cost reports, optionally scoped to a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_token": schema.StringAttribute{
+				Description: "Restrict results to cost reports belonging to this workspace.",
+				Optional:    true,
+			},
+			"cost_reports": schema.ListNestedAttribute{
+				Description: "The cost reports found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"token":           schema.StringAttribute{Computed: true},
+						"workspace_token": schema.StringAttribute{Computed: true},
+						"title":           schema.StringAttribute{Computed: true},
+						"filter":          schema.StringAttribute{Computed: true},
+						"segment_token":   schema.StringAttribute{Computed: true},
+						"groupings":       schema.StringAttribute{Computed: true},
+						"date_interval":   schema.StringAttribute{Computed: true},
+						"date_bucket":     schema.StringAttribute{Computed: true},
+						"settings_json":   schema.StringAttribute{Computed: true},
+						"created_at":      schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *costReportsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *costReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config costReportsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	costReports, err := d.client.CostReports.ListCostReports(ctx, &cloudpulse.ListParams{
+		WorkspaceToken: config.WorkspaceToken.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Cost Reports", err.Error())
+		return
+	}
+
+	model := costReportsDataSourceModel{
+		WorkspaceToken: config.WorkspaceToken,
+		CostReports:    make([]costReportResourceModel, 0, len(costReports)),
+	}
+	for _, c := range costReports {
+		reportModel, diags := costReportModelFromAPI(&c, types.StringNull())
+		resp.Diagnostics.Append(diags...)
+		model.CostReports = append(model.CostReports, reportModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &dashboardResource{}
+	_ resource.ResourceWithConfigure   = &dashboardResource{}
+	_ resource.ResourceWithImportState = &dashboardResource{}
+)
+
+func NewDashboardResource() resource.Resource {
+	return &dashboardResource{}
+}
+
+type dashboardResource struct {
+	client *cloudpulse.Client
+}
+
+type dashboardResourceModel struct {
+	Token          types.String `tfsdk:"token"`
+	WorkspaceToken types.String `tfsdk:"workspace_token"`
+	Title          types.String `tfsdk:"title"`
+	DateInterval   types.String `tfsdk:"date_interval"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse dashboard that groups cost reports for a shared view.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the dashboard.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this dashboard belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Display title of the dashboard.",
+				Required:    true,
+			},
+			"date_interval": schema.StringAttribute{
+				Description: "Default date interval for reports on this dashboard, e.g. \"last_30_days\". Defaults to \"last_30_days\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("last_30_days"),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the dashboard was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *dashboardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, _, err := r.client.Dashboards.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+		"workspace_token": plan.WorkspaceToken.ValueString(),
+		"title":           plan.Title.ValueString(),
+		"date_interval":   plan.DateInterval.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Dashboard", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, dashboardModelFromAPI(dashboard))...)
+}
+
+func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, _, err := r.client.Dashboards.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Dashboard", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, dashboardModelFromAPI(dashboard))...)
+}
+
+func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, _, err := r.client.Dashboards.Update(ctx, state.Token.ValueString(), map[string]interface{}{
+		"title":         plan.Title.ValueString(),
+		"date_interval": plan.DateInterval.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Dashboard", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, dashboardModelFromAPI(dashboard))...)
+}
+
+func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Dashboards.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Dashboard", err.Error())
+	}
+}
+
+func (r *dashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func dashboardModelFromAPI(d *cloudpulse.Dashboard) dashboardResourceModel {
+	return dashboardResourceModel{
+		Token:          types.StringValue(d.Token),
+		WorkspaceToken: types.StringValue(d.WorkspaceToken),
+		Title:          types.StringValue(d.Title),
+		DateInterval:   types.StringValue(d.DateInterval),
+		CreatedAt:      types.StringValue(d.CreatedAt),
+	}
+}
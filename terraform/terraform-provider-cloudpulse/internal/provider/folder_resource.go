@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &folderResource{}
+	_ resource.ResourceWithConfigure   = &folderResource{}
+	_ resource.ResourceWithImportState = &folderResource{}
+)
+
+func NewFolderResource() resource.Resource {
+	return &folderResource{}
+}
+
+type folderResource struct {
+	client *cloudpulse.Client
+}
+
+type folderResourceModel struct {
+	Token          types.String `tfsdk:"token"`
+	WorkspaceToken types.String `tfsdk:"workspace_token"`
+	Title          types.String `tfsdk:"title"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *folderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse folder used to organize cost reports and dashboards within a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this folder belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Display title of the folder.",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the folder was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan folderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, _, err := r.client.Folders.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+		"workspace_token": plan.WorkspaceToken.ValueString(),
+		"title":           plan.Title.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Folder", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, folderModelFromAPI(folder))...)
+}
+
+func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state folderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, _, err := r.client.Folders.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Folder", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, folderModelFromAPI(folder))...)
+}
+
+func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state folderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, _, err := r.client.Folders.Update(ctx, state.Token.ValueString(), map[string]interface{}{
+		"title": plan.Title.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Folder", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, folderModelFromAPI(folder))...)
+}
+
+func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state folderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Folders.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Folder", err.Error())
+	}
+}
+
+func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func folderModelFromAPI(f *cloudpulse.Folder) folderResourceModel {
+	return folderResourceModel{
+		Token:          types.StringValue(f.Token),
+		WorkspaceToken: types.StringValue(f.WorkspaceToken),
+		Title:          types.StringValue(f.Title),
+		CreatedAt:      types.StringValue(f.CreatedAt),
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ datasource.DataSource              = &foldersDataSource{}
+	_ datasource.DataSourceWithConfigure = &foldersDataSource{}
+)
+
+func NewFoldersDataSource() datasource.DataSource {
+	return &foldersDataSource{}
+}
+
+type foldersDataSource struct {
+	client *cloudpulse.Client
+}
+
+type foldersDataSourceModel struct {
+	WorkspaceToken types.String          `tfsdk:"workspace_token"`
+	Folders        []folderResourceModel `tfsdk:"folders"`
+}
+
+func (d *foldersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folders"
+}
+
+func (d *foldersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists CloudPulse folders, optionally scoped to a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_token": schema.StringAttribute{
+				Description: "Restrict results to folders belonging to this workspace.",
+				Optional:    true,
+			},
+			"folders": schema.ListNestedAttribute{
+				Description: "The folders found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"token":           schema.StringAttribute{Computed: true},
+						"workspace_token": schema.StringAttribute{Computed: true},
+						"title":           schema.StringAttribute{Computed: true},
+						"created_at":      schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *foldersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *foldersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config foldersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders, err := d.client.Folders.ListFolders(ctx, &cloudpulse.ListParams{
+		WorkspaceToken: config.WorkspaceToken.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Folders", err.Error())
+		return
+	}
+
+	model := foldersDataSourceModel{
+		WorkspaceToken: config.WorkspaceToken,
+		Folders:        make([]folderResourceModel, 0, len(folders)),
+	}
+	for _, f := range folders {
+		model.Folders = append(model.Folders, folderModelFromAPI(&f))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &notificationResource{}
+	_ resource.ResourceWithConfigure   = &notificationResource{}
+	_ resource.ResourceWithImportState = &notificationResource{}
+)
+
+func NewNotificationResource() resource.Resource {
+	return &notificationResource{}
+}
+
+type notificationResource struct {
+	client *cloudpulse.Client
+}
+
+type notificationResourceModel struct {
+	Token                types.String `tfsdk:"token"`
+	WorkspaceToken       types.String `tfsdk:"workspace_token"`
+	Title                types.String `tfsdk:"title"`
+	ThresholdExpression  types.String `tfsdk:"threshold_expression"`
+	DateInterval         types.String `tfsdk:"date_interval"`
+	Groupings            types.String `tfsdk:"groupings"`
+	Channel              types.String `tfsdk:"channel"`
+	ChannelTarget        types.String `tfsdk:"channel_target"`
+	ChannelTargetVersion types.Int64  `tfsdk:"channel_target_version"`
+	ChannelTargetHash    types.String `tfsdk:"channel_target_hash"`
+	PayloadTemplate      types.String `tfsdk:"payload_template"`
+	SuppressionHours     types.Int64  `tfsdk:"suppression_hours"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+}
+
+func (r *notificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse notification, which fires an alert through a delivery channel whenever a cost threshold is crossed.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the notification.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this notification belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Display title of the notification.",
+				Required:    true,
+			},
+			"threshold_expression": schema.StringAttribute{
+				Description: "Cost threshold expression that triggers the alert, e.g. \"costs.total > 10000\".",
+				Required:    true,
+			},
+			"date_interval": schema.StringAttribute{
+				Description: "Date range the threshold expression is evaluated over, e.g. \"last_7_days\".",
+				Required:    true,
+			},
+			"groupings": schema.StringAttribute{
+				Description: "Comma-separated dimensions the threshold is evaluated per-group over, e.g. \"service\".",
+				Optional:    true,
+			},
+			"channel": schema.StringAttribute{
+				Description: "Delivery channel: \"email\", \"slack_webhook\", \"pagerduty_service_key\", or \"generic_webhook_url\".",
+				Required:    true,
+			},
+			"channel_target": schema.StringAttribute{
+				Description: "Destination for the channel (email address, webhook URL, or service key). Write-only: Terraform never persists this value to state; see channel_target_hash for drift detection.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"channel_target_version": schema.Int64Attribute{
+				Description: "Arbitrary value that must change whenever channel_target changes. Because channel_target is write-only, Terraform can't detect a rotated secret on its own; bump this to force CloudPulse to re-verify and store the new value.",
+				Optional:    true,
+			},
+			"channel_target_hash": schema.StringAttribute{
+				Description: "SHA-256 hash of channel_target as currently on file, used to detect out-of-band rotation without storing the secret itself.",
+				Computed:    true,
+			},
+			"payload_template": schema.StringAttribute{
+				Description: "Go text/template source evaluated against the triggering report snapshot to build the notification payload.",
+				Optional:    true,
+			},
+			"suppression_hours": schema.Int64Attribute{
+				Description: "Hours to suppress re-notification after a firing alert. Defaults to 0 (no suppression).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the notification was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *notificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelTarget types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("channel_target"), &channelTarget)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, _, err := r.client.Notifications.Test(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), notificationRequestBody(plan, channelTarget)); err != nil {
+		resp.Diagnostics.AddError("Error Verifying Notification Channel", err.Error())
+		return
+	}
+
+	notification, _, err := r.client.Notifications.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), notificationRequestBody(plan, channelTarget))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Notification", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, notificationModelFromAPI(notification, plan.ChannelTargetVersion))...)
+}
+
+func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, _, err := r.client.Notifications.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Notification", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, notificationModelFromAPI(notification, state.ChannelTargetVersion))...)
+}
+
+func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelTarget types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("channel_target"), &channelTarget)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ChannelTargetVersion.ValueInt64() != state.ChannelTargetVersion.ValueInt64() {
+		if _, _, err := r.client.Notifications.Test(ctx, notificationRequestBody(plan, channelTarget)); err != nil {
+			resp.Diagnostics.AddError("Error Verifying Notification Channel", err.Error())
+			return
+		}
+	}
+
+	notification, _, err := r.client.Notifications.Update(ctx, state.Token.ValueString(), notificationRequestBody(plan, channelTarget))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Notification", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, notificationModelFromAPI(notification, plan.ChannelTargetVersion))...)
+}
+
+func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Notifications.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Notification", err.Error())
+	}
+}
+
+func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func notificationRequestBody(plan notificationResourceModel, channelTarget types.String) map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_token":      plan.WorkspaceToken.ValueString(),
+		"title":                plan.Title.ValueString(),
+		"threshold_expression": plan.ThresholdExpression.ValueString(),
+		"date_interval":        plan.DateInterval.ValueString(),
+		"groupings":            plan.Groupings.ValueString(),
+		"channel":              plan.Channel.ValueString(),
+		"channel_target":       channelTarget.ValueString(),
+		"payload_template":     plan.PayloadTemplate.ValueString(),
+		"suppression_hours":    plan.SuppressionHours.ValueInt64(),
+	}
+}
+
+// notificationModelFromAPI builds the resource model from the API
+// response. channel_target is write-only, so Terraform never persists it;
+// this always sets ChannelTarget to null and instead carries over
+// channelTargetVersion from the caller's plan/state so the version number
+// the user supplies round-trips like any other ordinary attribute. API
+// responses report only ChannelTargetHash, which this stores as-is so
+// that out-of-band rotation of the secret shows up as drift.
+func notificationModelFromAPI(n *cloudpulse.Notification, channelTargetVersion types.Int64) notificationResourceModel {
+	return notificationResourceModel{
+		Token:                types.StringValue(n.Token),
+		WorkspaceToken:       types.StringValue(n.WorkspaceToken),
+		Title:                types.StringValue(n.Title),
+		ThresholdExpression:  types.StringValue(n.ThresholdExpression),
+		DateInterval:         types.StringValue(n.DateInterval),
+		Groupings:            types.StringValue(n.Groupings),
+		Channel:              types.StringValue(n.Channel),
+		ChannelTarget:        types.StringNull(),
+		ChannelTargetVersion: channelTargetVersion,
+		ChannelTargetHash:    types.StringValue(n.ChannelTargetHash),
+		PayloadTemplate:      types.StringValue(n.PayloadTemplate),
+		SuppressionHours:     types.Int64Value(int64(n.SuppressionHours)),
+		CreatedAt:            types.StringValue(n.CreatedAt),
+	}
+}
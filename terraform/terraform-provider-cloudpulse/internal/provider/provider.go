@@ -9,6 +9,8 @@
 //   - cloudpulse_segment
 //   - cloudpulse_team
 //   - cloudpulse_virtual_tag
+//   - cloudpulse_notification
+//   - cloudpulse_workspace_bundle
 //
 // Data Sources:
 //   - cloudpulse_workspaces
@@ -74,6 +76,31 @@
 //	  date_interval   = "last_30_days"
 //	}
 //
+//	resource "cloudpulse_notification" "budget" {
+//	  title                 = "EC2 Budget"
+//	  workspace_token       = cloudpulse_workspace.prod.token
+//	  threshold_expression  = "costs.total > 10000"
+//	  date_interval         = "last_7_days"
+//	  channel               = "slack_webhook"
+//	  channel_target        = var.slack_webhook_url
+//	  channel_target_version = 1
+//	}
+//
+//	resource "cloudpulse_workspace_bundle" "prod" {
+//	  name = "Production"
+//
+//	  folders {
+//	    title = "Engineering"
+//	  }
+//
+//	  cost_reports {
+//	    title         = "EC2 Monthly"
+//	    filter        = "costs.service = 'Amazon EC2'"
+//	    groupings     = "service"
+//	    date_interval = "last_30_days"
+//	  }
+//	}
+//
 //	data "cloudpulse_workspaces" "all" {}
 //
 //	data "cloudpulse_cost_reports" "prod" {
@@ -83,12 +110,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
 )
 
 var _ provider.Provider = &CloudPulseProvider{}
@@ -136,17 +169,72 @@ func (p *CloudPulseProvider) Configure(ctx context.Context, req provider.Configu
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// API client would be initialized here and passed to resources via resp.DataSourceData / resp.ResourceData
+
+	apiToken := os.Getenv("CLOUDPULSE_API_TOKEN")
+	if !config.APIToken.IsNull() && config.APIToken.ValueString() != "" {
+		apiToken = config.APIToken.ValueString()
+	}
+	if apiToken == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Missing CloudPulse API Token",
+			"The provider cannot create the CloudPulse API client as there is no api_token. "+
+				"Set the api_token attribute in the provider configuration or the CLOUDPULSE_API_TOKEN environment variable.",
+		)
+		return
+	}
+
+	opts := []cloudpulse.ClientOption{
+		cloudpulse.WithUserAgent(fmt.Sprintf("terraform-provider-cloudpulse/%s", p.version)),
+	}
+	if !config.Host.IsNull() && config.Host.ValueString() != "" {
+		opts = append(opts, cloudpulse.WithBaseURL(fmt.Sprintf("%s/api/%s", config.Host.ValueString(), cloudpulse.APIVersion)))
+	}
+
+	client := cloudpulse.NewClientWithOptions(apiToken, opts...)
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
 }
 
 func (p *CloudPulseProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		// Each would be a full resource implementation.
-		// Stubbed for structure — full CRUD implementation follows the
-		// terraform-plugin-framework patterns.
+		NewWorkspaceResource,
+		NewCostReportResource,
+		NewFolderResource,
+		NewSavedFilterResource,
+		NewDashboardResource,
+		NewSegmentResource,
+		NewTeamResource,
+		NewVirtualTagResource,
+		NewNotificationResource,
+		NewWorkspaceBundleResource,
 	}
 }
 
 func (p *CloudPulseProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewWorkspacesDataSource,
+		NewCostReportsDataSource,
+		NewFoldersDataSource,
+	}
+}
+
+// clientFromProviderData extracts the *cloudpulse.Client configured in
+// CloudPulseProvider.Configure from providerData, appending a diagnostic
+// and returning nil if the type doesn't match (which would indicate a
+// provider bug rather than a user error).
+func clientFromProviderData(providerData any, diags *diag.Diagnostics) *cloudpulse.Client {
+	if providerData == nil {
+		return nil
+	}
+	client, ok := providerData.(*cloudpulse.Client)
+	if !ok {
+		diags.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cloudpulse.Client, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+	return client
 }
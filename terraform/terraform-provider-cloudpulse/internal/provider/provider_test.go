@@ -0,0 +1,856 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccProviderFactories configures the provider under test. Every
+// acceptance test points the provider at a fixture server (see
+// newWorkspaceFixtureServer) via the "host" attribute, so these tests run
+// under TF_ACC=1 without a live CloudPulse account or API token.
+var testAccProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"cloudpulse": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+}
+
+// newWorkspaceFixtureServer starts an in-memory stand-in for the
+// CloudPulse /workspaces endpoints, supporting the Create/Read/Update/
+// Delete cycle that TestAccWorkspaceResource exercises. It is torn down
+// automatically via t.Cleanup.
+func newWorkspaceFixtureServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	fx := &workspaceFixture{store: map[string]map[string]interface{}{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/workspaces", fx.handleCollection)
+	mux.HandleFunc("/api/v2/workspaces/", fx.handleItem)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+type workspaceFixture struct {
+	mu    sync.Mutex
+	seq   int
+	store map[string]map[string]interface{}
+}
+
+func (fx *workspaceFixture) handleCollection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		fx.mu.Lock()
+		defer fx.mu.Unlock()
+		workspaces := make([]map[string]interface{}, 0, len(fx.store))
+		for _, record := range fx.store {
+			workspaces = append(workspaces, record)
+		}
+		writeFixtureJSON(w, http.StatusOK, map[string]interface{}{"workspaces": workspaces, "links": map[string]string{}})
+	case http.MethodPost:
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		fx.mu.Lock()
+		fx.seq++
+		token := fmt.Sprintf("ws_%d", fx.seq)
+		record := map[string]interface{}{
+			"token":      token,
+			"name":       body["name"],
+			"is_default": false,
+			"created_at": "2024-01-01T00:00:00Z",
+		}
+		fx.store[token] = record
+		fx.mu.Unlock()
+
+		writeFixtureJSON(w, http.StatusCreated, record)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (fx *workspaceFixture) handleItem(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.URL.Path, "/api/v2/workspaces/")
+
+	fx.mu.Lock()
+	defer fx.mu.Unlock()
+	record, ok := fx.store[token]
+
+	switch r.Method {
+	case http.MethodGet:
+		if !ok {
+			writeFixtureJSON(w, http.StatusNotFound, map[string]interface{}{"code": "not_found", "message": "workspace not found"})
+			return
+		}
+		writeFixtureJSON(w, http.StatusOK, record)
+	case http.MethodPatch:
+		if !ok {
+			writeFixtureJSON(w, http.StatusNotFound, map[string]interface{}{"code": "not_found", "message": "workspace not found"})
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if name, ok := body["name"]; ok {
+			record["name"] = name
+		}
+		writeFixtureJSON(w, http.StatusOK, record)
+	case http.MethodDelete:
+		delete(fx.store, token)
+		writeFixtureJSON(w, http.StatusOK, map[string]interface{}{"message": "deleted"})
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func writeFixtureJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// genericFixtureServer is a reusable stand-in for the CloudPulse REST
+// endpoints shared by most resources: POST/GET on the collection path,
+// and GET/PATCH/DELETE on "<collection>/<token>". Unlike workspaceFixture
+// it doesn't hand-pick which request fields become which response
+// fields; it simply echoes the request body into the stored record,
+// which is enough for resources whose fields round-trip as-is.
+type genericFixtureServer struct {
+	mu         sync.Mutex
+	seq        int
+	tokenParam string
+	listKey    string
+	store      map[string]map[string]interface{}
+}
+
+// newGenericFixtureServer starts a genericFixtureServer for collectionPath
+// (e.g. "/api/v2/segments"), minting tokens as "<tokenPrefix>_<n>" and
+// wrapping list responses under listKey (e.g. "segments"). It is torn
+// down automatically via t.Cleanup.
+func newGenericFixtureServer(t *testing.T, collectionPath, tokenPrefix, listKey string) *httptest.Server {
+	t.Helper()
+	fx := &genericFixtureServer{tokenParam: tokenPrefix, listKey: listKey, store: map[string]map[string]interface{}{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc(collectionPath, fx.handleCollection)
+	mux.HandleFunc(collectionPath+"/", fx.handleItem(collectionPath))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (fx *genericFixtureServer) handleCollection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		fx.mu.Lock()
+		defer fx.mu.Unlock()
+		records := make([]map[string]interface{}, 0, len(fx.store))
+		for _, record := range fx.store {
+			records = append(records, record)
+		}
+		writeFixtureJSON(w, http.StatusOK, map[string]interface{}{fx.listKey: records, "links": map[string]string{}})
+	case http.MethodPost:
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		writeFixtureJSON(w, http.StatusCreated, fx.create(body))
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// create mints a token and stores body under it, the same way the POST
+// branch of handleCollection does. It's split out so bundleFixture can
+// seed a child record directly (as the workspace_bundles endpoint does)
+// without a real HTTP round-trip.
+func (fx *genericFixtureServer) create(body map[string]interface{}) map[string]interface{} {
+	fx.mu.Lock()
+	defer fx.mu.Unlock()
+	fx.seq++
+	token := fmt.Sprintf("%s_%d", fx.tokenParam, fx.seq)
+	record := map[string]interface{}{"created_at": "2024-01-01T00:00:00Z"}
+	for k, v := range body {
+		record[k] = v
+	}
+	record["token"] = token
+	fx.store[token] = record
+	return record
+}
+
+func (fx *genericFixtureServer) handleItem(collectionPath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.URL.Path, collectionPath+"/")
+
+		fx.mu.Lock()
+		defer fx.mu.Unlock()
+		record, ok := fx.store[token]
+
+		switch r.Method {
+		case http.MethodGet:
+			if !ok {
+				writeFixtureJSON(w, http.StatusNotFound, map[string]interface{}{"code": "not_found", "message": "resource not found"})
+				return
+			}
+			writeFixtureJSON(w, http.StatusOK, record)
+		case http.MethodPatch:
+			if !ok {
+				writeFixtureJSON(w, http.StatusNotFound, map[string]interface{}{"code": "not_found", "message": "resource not found"})
+				return
+			}
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			for k, v := range body {
+				record[k] = v
+			}
+			writeFixtureJSON(w, http.StatusOK, record)
+		case http.MethodDelete:
+			delete(fx.store, token)
+			writeFixtureJSON(w, http.StatusOK, map[string]interface{}{"message": "deleted"})
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func TestAccWorkspaceResource(t *testing.T) {
+	server := newWorkspaceFixtureServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceResourceConfig(server.URL, "Production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_workspace.test", "name", "Production"),
+					resource.TestCheckResourceAttrSet("cloudpulse_workspace.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_workspace.test",
+				Config:            testAccWorkspaceResourceConfig(server.URL, "Production"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceResourceConfig(server.URL, "Production Renamed"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_workspace.test", "name", "Production Renamed"),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceResourceConfig(host, name string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_workspace" "test" {
+  name = %q
+}
+`, host, name)
+}
+
+func TestAccCostReportResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/cost_reports", "cr", "cost_reports")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostReportResourceConfig(server.URL, "EC2 Monthly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_cost_report.test", "title", "EC2 Monthly"),
+					resource.TestCheckResourceAttrSet("cloudpulse_cost_report.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_cost_report.test",
+				Config:            testAccCostReportResourceConfig(server.URL, "EC2 Monthly"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCostReportResourceConfig(server.URL, "EC2 Monthly Renamed"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_cost_report.test", "title", "EC2 Monthly Renamed"),
+			},
+		},
+	})
+}
+
+func testAccCostReportResourceConfig(host, title string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_cost_report" "test" {
+  workspace_token = "ws_1"
+  title           = %q
+  filter          = "costs.service = 'Amazon EC2'"
+  groupings       = "service"
+  date_interval   = "last_30_days"
+}
+`, host, title)
+}
+
+func TestAccFolderResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/folders", "fld", "folders")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderResourceConfig(server.URL, "Engineering"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_folder.test", "title", "Engineering"),
+					resource.TestCheckResourceAttrSet("cloudpulse_folder.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_folder.test",
+				Config:            testAccFolderResourceConfig(server.URL, "Engineering"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderResourceConfig(server.URL, "Platform"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_folder.test", "title", "Platform"),
+			},
+		},
+	})
+}
+
+func testAccFolderResourceConfig(host, title string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_folder" "test" {
+  workspace_token = "ws_1"
+  title           = %q
+}
+`, host, title)
+}
+
+func TestAccSavedFilterResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/saved_filters", "sf", "saved_filters")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSavedFilterResourceConfig(server.URL, "Production Only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_saved_filter.test", "title", "Production Only"),
+					resource.TestCheckResourceAttrSet("cloudpulse_saved_filter.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_saved_filter.test",
+				Config:            testAccSavedFilterResourceConfig(server.URL, "Production Only"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSavedFilterResourceConfig(server.URL, "Production Only Renamed"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_saved_filter.test", "title", "Production Only Renamed"),
+			},
+		},
+	})
+}
+
+func testAccSavedFilterResourceConfig(host, title string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_saved_filter" "test" {
+  workspace_token = "ws_1"
+  title           = %q
+  filter          = "tags.env = 'production'"
+}
+`, host, title)
+}
+
+func TestAccDashboardResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/dashboards", "dash", "dashboards")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardResourceConfig(server.URL, "Cost Overview"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_dashboard.test", "title", "Cost Overview"),
+					resource.TestCheckResourceAttrSet("cloudpulse_dashboard.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_dashboard.test",
+				Config:            testAccDashboardResourceConfig(server.URL, "Cost Overview"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDashboardResourceConfig(server.URL, "Cost Overview Renamed"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_dashboard.test", "title", "Cost Overview Renamed"),
+			},
+		},
+	})
+}
+
+func testAccDashboardResourceConfig(host, title string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_dashboard" "test" {
+  workspace_token = "ws_1"
+  title           = %q
+  date_interval   = "last_30_days"
+}
+`, host, title)
+}
+
+func TestAccSegmentResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/segments", "seg", "segments")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentResourceConfig(server.URL, "Backend Services"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_segment.test", "title", "Backend Services"),
+					resource.TestCheckResourceAttrSet("cloudpulse_segment.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_segment.test",
+				Config:            testAccSegmentResourceConfig(server.URL, "Backend Services"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSegmentResourceConfig(server.URL, "Backend Services Renamed"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_segment.test", "title", "Backend Services Renamed"),
+			},
+		},
+	})
+}
+
+func testAccSegmentResourceConfig(host, title string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_segment" "test" {
+  workspace_token = "ws_1"
+  title           = %q
+  filter          = "costs.service = 'Amazon EC2' OR costs.service = 'Amazon RDS'"
+  priority        = 1
+}
+`, host, title)
+}
+
+func TestAccTeamResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/teams", "team", "teams")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfig(server.URL, "SRE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_team.test", "name", "SRE"),
+					resource.TestCheckResourceAttrSet("cloudpulse_team.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_team.test",
+				Config:            testAccTeamResourceConfig(server.URL, "SRE"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamResourceConfig(server.URL, "SRE Renamed"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_team.test", "name", "SRE Renamed"),
+			},
+		},
+	})
+}
+
+func testAccTeamResourceConfig(host, name string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_team" "test" {
+  workspace_token = "ws_1"
+  name            = %q
+  description     = "Site Reliability Engineering"
+}
+`, host, name)
+}
+
+func TestAccVirtualTagResource(t *testing.T) {
+	server := newGenericFixtureServer(t, "/api/v2/virtual_tags", "vt", "virtual_tags")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualTagResourceConfig(server.URL, "team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_virtual_tag.test", "key", "team"),
+					resource.TestCheckResourceAttrSet("cloudpulse_virtual_tag.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_virtual_tag.test",
+				Config:            testAccVirtualTagResourceConfig(server.URL, "team"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVirtualTagResourceConfig(server.URL, "cost_center"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_virtual_tag.test", "key", "cost_center"),
+			},
+		},
+	})
+}
+
+func testAccVirtualTagResourceConfig(host, key string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_virtual_tag" "test" {
+  workspace_token = "ws_1"
+  key             = %q
+  overridable     = true
+
+  values {
+    name   = "platform"
+    filter = "tags.team = 'platform'"
+  }
+}
+`, host, key)
+}
+
+// newNotificationFixtureServer extends a genericFixtureServer for
+// /api/v2/notifications with a handler for POST
+// /api/v2/notifications/test, the dry-run channel-verification endpoint
+// that notificationResource.Create calls before persisting anything, and
+// that Update calls first when channel_target_version changes.
+func newNotificationFixtureServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	fx := &genericFixtureServer{tokenParam: "notif", listKey: "notifications", store: map[string]map[string]interface{}{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/notifications/test", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeFixtureJSON(w, http.StatusOK, map[string]interface{}{"message": "channel verified"})
+	})
+	mux.HandleFunc("/api/v2/notifications", fx.handleCollection)
+	mux.HandleFunc("/api/v2/notifications/", fx.handleItem("/api/v2/notifications"))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAccNotificationResource(t *testing.T) {
+	server := newNotificationFixtureServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationResourceConfig(server.URL, "EC2 Budget", "costs.total > 10000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_notification.test", "title", "EC2 Budget"),
+					resource.TestCheckResourceAttr("cloudpulse_notification.test", "threshold_expression", "costs.total > 10000"),
+					resource.TestCheckResourceAttrSet("cloudpulse_notification.test", "token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_notification.test",
+				Config:            testAccNotificationResourceConfig(server.URL, "EC2 Budget", "costs.total > 10000"),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// channel_target is write-only and never read back, and
+				// channel_target_version only round-trips through plan/state
+				// (not the API response), so a freshly imported resource
+				// can't reproduce either.
+				ImportStateVerifyIgnore: []string{"channel_target", "channel_target_version"},
+			},
+			{
+				Config: testAccNotificationResourceConfig(server.URL, "EC2 Budget", "costs.total > 20000"),
+				Check:  resource.TestCheckResourceAttr("cloudpulse_notification.test", "threshold_expression", "costs.total > 20000"),
+			},
+		},
+	})
+}
+
+func testAccNotificationResourceConfig(host, title, thresholdExpression string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_notification" "test" {
+  workspace_token      = "ws_1"
+  title                = %q
+  threshold_expression = %q
+  date_interval        = "last_7_days"
+  channel              = "slack_webhook"
+  channel_target       = "https://hooks.example.com/services/T000/B000/XXX"
+}
+`, host, title, thresholdExpression)
+}
+
+// bundleFixture is a stand-in for the CloudPulse /workspace_bundles
+// endpoint and the per-child endpoints (workspaces, folders, segments,
+// ...) that workspaceBundleResource's Read and Update fall back to: Read
+// reconstructs a bundle by listing each child collection, and Update
+// reconciles them one collection at a time instead of re-posting the
+// whole bundle. Each child collection is backed by its own
+// genericFixtureServer so those requests behave exactly like they would
+// against cloudpulse_folder, cloudpulse_segment, etc.
+type bundleFixture struct {
+	mu        sync.Mutex
+	seq       int
+	workspace map[string]interface{}
+
+	folders      *genericFixtureServer
+	segments     *genericFixtureServer
+	virtualTags  *genericFixtureServer
+	savedFilters *genericFixtureServer
+	dashboards   *genericFixtureServer
+	costReports  *genericFixtureServer
+}
+
+func newWorkspaceBundleFixtureServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	fx := &bundleFixture{
+		folders:      &genericFixtureServer{tokenParam: "fld", listKey: "folders", store: map[string]map[string]interface{}{}},
+		segments:     &genericFixtureServer{tokenParam: "seg", listKey: "segments", store: map[string]map[string]interface{}{}},
+		virtualTags:  &genericFixtureServer{tokenParam: "vt", listKey: "virtual_tags", store: map[string]map[string]interface{}{}},
+		savedFilters: &genericFixtureServer{tokenParam: "sf", listKey: "saved_filters", store: map[string]map[string]interface{}{}},
+		dashboards:   &genericFixtureServer{tokenParam: "dash", listKey: "dashboards", store: map[string]map[string]interface{}{}},
+		costReports:  &genericFixtureServer{tokenParam: "cr", listKey: "cost_reports", store: map[string]map[string]interface{}{}},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/workspace_bundles", fx.handleBundleCollection)
+	mux.HandleFunc("/api/v2/workspace_bundles/", fx.handleBundleItem)
+	mux.HandleFunc("/api/v2/workspaces/", fx.handleWorkspaceItem)
+	mux.HandleFunc("/api/v2/folders", fx.folders.handleCollection)
+	mux.HandleFunc("/api/v2/folders/", fx.folders.handleItem("/api/v2/folders"))
+	mux.HandleFunc("/api/v2/segments", fx.segments.handleCollection)
+	mux.HandleFunc("/api/v2/segments/", fx.segments.handleItem("/api/v2/segments"))
+	mux.HandleFunc("/api/v2/virtual_tags", fx.virtualTags.handleCollection)
+	mux.HandleFunc("/api/v2/virtual_tags/", fx.virtualTags.handleItem("/api/v2/virtual_tags"))
+	mux.HandleFunc("/api/v2/saved_filters", fx.savedFilters.handleCollection)
+	mux.HandleFunc("/api/v2/saved_filters/", fx.savedFilters.handleItem("/api/v2/saved_filters"))
+	mux.HandleFunc("/api/v2/dashboards", fx.dashboards.handleCollection)
+	mux.HandleFunc("/api/v2/dashboards/", fx.dashboards.handleItem("/api/v2/dashboards"))
+	mux.HandleFunc("/api/v2/cost_reports", fx.costReports.handleCollection)
+	mux.HandleFunc("/api/v2/cost_reports/", fx.costReports.handleItem("/api/v2/cost_reports"))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (fx *bundleFixture) handleBundleCollection(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body struct {
+		Workspace    map[string]interface{}   `json:"workspace"`
+		Folders      []map[string]interface{} `json:"folders"`
+		Segments     []map[string]interface{} `json:"segments"`
+		VirtualTags  []map[string]interface{} `json:"virtual_tags"`
+		SavedFilters []map[string]interface{} `json:"saved_filters"`
+		Dashboards   []map[string]interface{} `json:"dashboards"`
+		CostReports  []map[string]interface{} `json:"cost_reports"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	fx.mu.Lock()
+	fx.seq++
+	wsToken := fmt.Sprintf("ws_%d", fx.seq)
+	fx.workspace = map[string]interface{}{
+		"token":      wsToken,
+		"name":       body.Workspace["name"],
+		"is_default": body.Workspace["is_default"],
+		"created_at": "2024-01-01T00:00:00Z",
+	}
+	fx.mu.Unlock()
+
+	folders := make([]map[string]interface{}, 0, len(body.Folders))
+	for _, f := range body.Folders {
+		f["workspace_token"] = wsToken
+		folders = append(folders, fx.folders.create(f))
+	}
+	segments := make([]map[string]interface{}, 0, len(body.Segments))
+	for _, s := range body.Segments {
+		s["workspace_token"] = wsToken
+		segments = append(segments, fx.segments.create(s))
+	}
+	virtualTags := make([]map[string]interface{}, 0, len(body.VirtualTags))
+	for _, v := range body.VirtualTags {
+		v["workspace_token"] = wsToken
+		virtualTags = append(virtualTags, fx.virtualTags.create(v))
+	}
+	savedFilters := make([]map[string]interface{}, 0, len(body.SavedFilters))
+	for _, sf := range body.SavedFilters {
+		sf["workspace_token"] = wsToken
+		savedFilters = append(savedFilters, fx.savedFilters.create(sf))
+	}
+	dashboards := make([]map[string]interface{}, 0, len(body.Dashboards))
+	for _, d := range body.Dashboards {
+		d["workspace_token"] = wsToken
+		dashboards = append(dashboards, fx.dashboards.create(d))
+	}
+	costReports := make([]map[string]interface{}, 0, len(body.CostReports))
+	for _, c := range body.CostReports {
+		c["workspace_token"] = wsToken
+		costReports = append(costReports, fx.costReports.create(c))
+	}
+
+	writeFixtureJSON(w, http.StatusCreated, map[string]interface{}{
+		"workspace_token": wsToken,
+		"workspace":       fx.workspace,
+		"folders":         folders,
+		"segments":        segments,
+		"virtual_tags":    virtualTags,
+		"saved_filters":   savedFilters,
+		"dashboards":      dashboards,
+		"cost_reports":    costReports,
+		"created_at":      fx.workspace["created_at"],
+	})
+}
+
+func (fx *bundleFixture) handleBundleItem(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeFixtureJSON(w, http.StatusOK, map[string]interface{}{"message": "deleted"})
+}
+
+func (fx *bundleFixture) handleWorkspaceItem(w http.ResponseWriter, r *http.Request) {
+	fx.mu.Lock()
+	defer fx.mu.Unlock()
+	switch r.Method {
+	case http.MethodGet:
+		writeFixtureJSON(w, http.StatusOK, fx.workspace)
+	case http.MethodPatch:
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for k, v := range body {
+			fx.workspace[k] = v
+		}
+		writeFixtureJSON(w, http.StatusOK, fx.workspace)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// TestAccWorkspaceBundleResource covers the reconcile-by-title path in
+// reconcileBundleSegments: the third step keeps the segment's title
+// unchanged but changes its filter, which must PATCH the existing
+// segment rather than deleting and recreating it under a new token.
+func TestAccWorkspaceBundleResource(t *testing.T) {
+	server := newWorkspaceBundleFixtureServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceBundleResourceConfig(server.URL, "Bundle Test", "costs.service = 'Amazon EC2'"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_workspace_bundle.test", "name", "Bundle Test"),
+					resource.TestCheckResourceAttrSet("cloudpulse_workspace_bundle.test", "workspace_token"),
+					resource.TestCheckResourceAttr("cloudpulse_workspace_bundle.test", "folders.0.title", "Engineering"),
+					resource.TestCheckResourceAttr("cloudpulse_workspace_bundle.test", "segments.0.filter", "costs.service = 'Amazon EC2'"),
+					resource.TestCheckResourceAttrSet("cloudpulse_workspace_bundle.test", "segments.0.token"),
+				),
+			},
+			{
+				ResourceName:      "cloudpulse_workspace_bundle.test",
+				Config:            testAccWorkspaceBundleResourceConfig(server.URL, "Bundle Test", "costs.service = 'Amazon EC2'"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceBundleResourceConfig(server.URL, "Bundle Test", "costs.service = 'Amazon RDS'"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudpulse_workspace_bundle.test", "segments.0.filter", "costs.service = 'Amazon RDS'"),
+					resource.TestCheckResourceAttr("cloudpulse_workspace_bundle.test", "segments.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceBundleResourceConfig(host, name, segmentFilter string) string {
+	return fmt.Sprintf(`
+provider "cloudpulse" {
+  api_token = "test-token"
+  host      = %q
+}
+
+resource "cloudpulse_workspace_bundle" "test" {
+  name = %q
+
+  folders {
+    title = "Engineering"
+  }
+
+  segments {
+    title    = "Backend Services"
+    filter   = %q
+    priority = 1
+  }
+}
+`, host, name, segmentFilter)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &savedFilterResource{}
+	_ resource.ResourceWithConfigure   = &savedFilterResource{}
+	_ resource.ResourceWithImportState = &savedFilterResource{}
+)
+
+func NewSavedFilterResource() resource.Resource {
+	return &savedFilterResource{}
+}
+
+type savedFilterResource struct {
+	client *cloudpulse.Client
+}
+
+type savedFilterResourceModel struct {
+	Token          types.String `tfsdk:"token"`
+	WorkspaceToken types.String `tfsdk:"workspace_token"`
+	Title          types.String `tfsdk:"title"`
+	Filter         types.String `tfsdk:"filter"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *savedFilterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saved_filter"
+}
+
+func (r *savedFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse saved filter that can be reused across cost reports and dashboards.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the saved filter.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this saved filter belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Display title of the saved filter.",
+				Required:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "CloudPulse filter expression, e.g. \"tags.env = 'production'\".",
+				Required:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the saved filter was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *savedFilterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *savedFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan savedFilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedFilter, _, err := r.client.SavedFilters.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+		"workspace_token": plan.WorkspaceToken.ValueString(),
+		"title":           plan.Title.ValueString(),
+		"filter":          plan.Filter.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Saved Filter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, savedFilterModelFromAPI(savedFilter))...)
+}
+
+func (r *savedFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state savedFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedFilter, _, err := r.client.SavedFilters.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Saved Filter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, savedFilterModelFromAPI(savedFilter))...)
+}
+
+func (r *savedFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state savedFilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	savedFilter, _, err := r.client.SavedFilters.Update(ctx, state.Token.ValueString(), map[string]interface{}{
+		"title":  plan.Title.ValueString(),
+		"filter": plan.Filter.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Saved Filter", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, savedFilterModelFromAPI(savedFilter))...)
+}
+
+func (r *savedFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state savedFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.SavedFilters.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Saved Filter", err.Error())
+	}
+}
+
+func (r *savedFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func savedFilterModelFromAPI(f *cloudpulse.SavedFilter) savedFilterResourceModel {
+	return savedFilterResourceModel{
+		Token:          types.StringValue(f.Token),
+		WorkspaceToken: types.StringValue(f.WorkspaceToken),
+		Title:          types.StringValue(f.Title),
+		Filter:         types.StringValue(f.Filter),
+		CreatedAt:      types.StringValue(f.CreatedAt),
+	}
+}
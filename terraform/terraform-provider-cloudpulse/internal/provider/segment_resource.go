@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &segmentResource{}
+	_ resource.ResourceWithConfigure   = &segmentResource{}
+	_ resource.ResourceWithImportState = &segmentResource{}
+)
+
+func NewSegmentResource() resource.Resource {
+	return &segmentResource{}
+}
+
+type segmentResource struct {
+	client *cloudpulse.Client
+}
+
+type segmentResourceModel struct {
+	Token            types.String `tfsdk:"token"`
+	WorkspaceToken   types.String `tfsdk:"workspace_token"`
+	Title            types.String `tfsdk:"title"`
+	Description      types.String `tfsdk:"description"`
+	Filter           types.String `tfsdk:"filter"`
+	Priority         types.Int64  `tfsdk:"priority"`
+	TrackUnallocated types.Bool   `tfsdk:"track_unallocated"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (r *segmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (r *segmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse segment, a named slice of cost allocated by a filter expression.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the segment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this segment belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Display title of the segment.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Longer description of what this segment represents.",
+				Optional:    true,
+			},
+			"filter": schema.StringAttribute{
+				Description: "CloudPulse filter expression that selects costs into this segment.",
+				Optional:    true,
+			},
+			"priority": schema.Int64Attribute{
+				Description: "Evaluation priority relative to other segments; lower values are evaluated first. Defaults to 0.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+			},
+			"track_unallocated": schema.BoolAttribute{
+				Description: "Whether costs that don't match any segment's filter are tracked as \"unallocated\". Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the segment was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *segmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *segmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan segmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, _, err := r.client.Segments.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), segmentRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Segment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, segmentModelFromAPI(segment))...)
+}
+
+func (r *segmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state segmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, _, err := r.client.Segments.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Segment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, segmentModelFromAPI(segment))...)
+}
+
+func (r *segmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state segmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, _, err := r.client.Segments.Update(ctx, state.Token.ValueString(), segmentRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Segment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, segmentModelFromAPI(segment))...)
+}
+
+func (r *segmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state segmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Segments.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Segment", err.Error())
+	}
+}
+
+func (r *segmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func segmentRequestBody(plan segmentResourceModel) map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_token":   plan.WorkspaceToken.ValueString(),
+		"title":             plan.Title.ValueString(),
+		"description":       plan.Description.ValueString(),
+		"filter":            plan.Filter.ValueString(),
+		"priority":          plan.Priority.ValueInt64(),
+		"track_unallocated": plan.TrackUnallocated.ValueBool(),
+	}
+}
+
+func segmentModelFromAPI(s *cloudpulse.Segment) segmentResourceModel {
+	return segmentResourceModel{
+		Token:            types.StringValue(s.Token),
+		WorkspaceToken:   types.StringValue(s.WorkspaceToken),
+		Title:            types.StringValue(s.Title),
+		Description:      types.StringValue(s.Description),
+		Filter:           types.StringValue(s.Filter),
+		Priority:         types.Int64Value(int64(s.Priority)),
+		TrackUnallocated: types.BoolValue(s.TrackUnallocated),
+		CreatedAt:        types.StringValue(s.CreatedAt),
+	}
+}
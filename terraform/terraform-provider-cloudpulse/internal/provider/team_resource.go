@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &teamResource{}
+	_ resource.ResourceWithConfigure   = &teamResource{}
+	_ resource.ResourceWithImportState = &teamResource{}
+)
+
+func NewTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+type teamResource struct {
+	client *cloudpulse.Client
+}
+
+type teamResourceModel struct {
+	Token          types.String `tfsdk:"token"`
+	WorkspaceToken types.String `tfsdk:"workspace_token"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse team used to group cost visibility and ownership within a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the team.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this team belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the team.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Longer description of the team's purpose.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the team was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan teamResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, _, err := r.client.Teams.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+		"workspace_token": plan.WorkspaceToken.ValueString(),
+		"name":            plan.Name.ValueString(),
+		"description":     plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Team", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, teamModelFromAPI(team))...)
+}
+
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state teamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, _, err := r.client.Teams.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Team", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, teamModelFromAPI(team))...)
+}
+
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state teamResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, _, err := r.client.Teams.Update(ctx, state.Token.ValueString(), map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Team", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, teamModelFromAPI(team))...)
+}
+
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state teamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Teams.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Team", err.Error())
+	}
+}
+
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func teamModelFromAPI(t *cloudpulse.Team) teamResourceModel {
+	return teamResourceModel{
+		Token:          types.StringValue(t.Token),
+		WorkspaceToken: types.StringValue(t.WorkspaceToken),
+		Name:           types.StringValue(t.Name),
+		Description:    types.StringValue(t.Description),
+		CreatedAt:      types.StringValue(t.CreatedAt),
+	}
+}
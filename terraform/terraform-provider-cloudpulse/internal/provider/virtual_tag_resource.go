@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &virtualTagResource{}
+	_ resource.ResourceWithConfigure   = &virtualTagResource{}
+	_ resource.ResourceWithImportState = &virtualTagResource{}
+)
+
+func NewVirtualTagResource() resource.Resource {
+	return &virtualTagResource{}
+}
+
+type virtualTagResource struct {
+	client *cloudpulse.Client
+}
+
+type virtualTagResourceModel struct {
+	Token          types.String           `tfsdk:"token"`
+	WorkspaceToken types.String           `tfsdk:"workspace_token"`
+	Key            types.String           `tfsdk:"key"`
+	Description    types.String           `tfsdk:"description"`
+	Overridable    types.Bool             `tfsdk:"overridable"`
+	Values         []virtualTagValueModel `tfsdk:"values"`
+	CreatedAt      types.String           `tfsdk:"created_at"`
+}
+
+type virtualTagValueModel struct {
+	Name   types.String `tfsdk:"name"`
+	Filter types.String `tfsdk:"filter"`
+}
+
+func (r *virtualTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_tag"
+}
+
+func (r *virtualTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse virtual tag, a derived tag whose values are computed from filter expressions instead of provider-reported labels.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the virtual tag.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_token": schema.StringAttribute{
+				Description: "Token of the workspace this virtual tag belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "Tag key, e.g. \"team\".",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Longer description of what this virtual tag represents.",
+				Optional:    true,
+			},
+			"overridable": schema.BoolAttribute{
+				Description: "Whether a provider-reported tag with the same key overrides this virtual tag's computed value.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the virtual tag was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"values": schema.ListNestedBlock{
+				Description: "Ordered list of value definitions; the first matching filter wins.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The tag value assigned when filter matches.",
+							Required:    true,
+						},
+						"filter": schema.StringAttribute{
+							Description: "CloudPulse filter expression that selects costs into this value.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *virtualTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *virtualTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualTag, _, err := r.client.VirtualTags.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), virtualTagRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Virtual Tag", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, virtualTagModelFromAPI(virtualTag))...)
+}
+
+func (r *virtualTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualTag, _, err := r.client.VirtualTags.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Virtual Tag", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, virtualTagModelFromAPI(virtualTag))...)
+}
+
+func (r *virtualTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state virtualTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualTag, _, err := r.client.VirtualTags.Update(ctx, state.Token.ValueString(), virtualTagRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Virtual Tag", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, virtualTagModelFromAPI(virtualTag))...)
+}
+
+func (r *virtualTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.VirtualTags.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Virtual Tag", err.Error())
+	}
+}
+
+func (r *virtualTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func virtualTagRequestBody(plan virtualTagResourceModel) map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(plan.Values))
+	for _, v := range plan.Values {
+		values = append(values, map[string]interface{}{
+			"name":   v.Name.ValueString(),
+			"filter": v.Filter.ValueString(),
+		})
+	}
+	return map[string]interface{}{
+		"workspace_token": plan.WorkspaceToken.ValueString(),
+		"key":             plan.Key.ValueString(),
+		"description":     plan.Description.ValueString(),
+		"overridable":     plan.Overridable.ValueBool(),
+		"values":          values,
+	}
+}
+
+func virtualTagModelFromAPI(v *cloudpulse.VirtualTag) virtualTagResourceModel {
+	values := make([]virtualTagValueModel, 0, len(v.Values))
+	for _, raw := range v.Values {
+		name, _ := raw["name"].(string)
+		filter, _ := raw["filter"].(string)
+		values = append(values, virtualTagValueModel{
+			Name:   types.StringValue(name),
+			Filter: types.StringValue(filter),
+		})
+	}
+	return virtualTagResourceModel{
+		Token:          types.StringValue(v.Token),
+		WorkspaceToken: types.StringValue(v.WorkspaceToken),
+		Key:            types.StringValue(v.Key),
+		Description:    types.StringValue(v.Description),
+		Overridable:    types.BoolValue(v.Overridable),
+		Values:         values,
+		CreatedAt:      types.StringValue(v.CreatedAt),
+	}
+}
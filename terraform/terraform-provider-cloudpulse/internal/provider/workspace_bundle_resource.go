@@ -0,0 +1,923 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+// The workspace bundle resource manages an entire workspace topology
+// (the workspace plus any of its folders, segments, virtual tags, saved
+// filters, dashboards, and cost reports) as a single Terraform resource,
+// created atomically through BundlesService instead of one HTTP
+// round-trip per child. Its schema is the union of those children's own
+// resource schemas, trimmed to the fields needed to create and
+// distinguish them; state tracks each child's token so Update can PATCH
+// only the children that changed instead of recreating the bundle.
+var (
+	_ resource.Resource                     = &workspaceBundleResource{}
+	_ resource.ResourceWithConfigure        = &workspaceBundleResource{}
+	_ resource.ResourceWithConfigValidators = &workspaceBundleResource{}
+	_ resource.ResourceWithImportState      = &workspaceBundleResource{}
+)
+
+func NewWorkspaceBundleResource() resource.Resource {
+	return &workspaceBundleResource{}
+}
+
+type workspaceBundleResource struct {
+	client *cloudpulse.Client
+}
+
+type workspaceBundleResourceModel struct {
+	WorkspaceToken types.String             `tfsdk:"workspace_token"`
+	Name           types.String             `tfsdk:"name"`
+	IsDefault      types.Bool               `tfsdk:"is_default"`
+	Folders        []bundleFolderModel      `tfsdk:"folders"`
+	Segments       []bundleSegmentModel     `tfsdk:"segments"`
+	VirtualTags    []bundleVirtualTagModel  `tfsdk:"virtual_tags"`
+	SavedFilters   []bundleSavedFilterModel `tfsdk:"saved_filters"`
+	Dashboards     []bundleDashboardModel   `tfsdk:"dashboards"`
+	CostReports    []bundleCostReportModel  `tfsdk:"cost_reports"`
+	CreatedAt      types.String             `tfsdk:"created_at"`
+}
+
+type bundleFolderModel struct {
+	Token types.String `tfsdk:"token"`
+	Title types.String `tfsdk:"title"`
+}
+
+type bundleSegmentModel struct {
+	Token    types.String `tfsdk:"token"`
+	Title    types.String `tfsdk:"title"`
+	Filter   types.String `tfsdk:"filter"`
+	Priority types.Int64  `tfsdk:"priority"`
+}
+
+type bundleVirtualTagModel struct {
+	Token       types.String `tfsdk:"token"`
+	Key         types.String `tfsdk:"key"`
+	Description types.String `tfsdk:"description"`
+}
+
+type bundleSavedFilterModel struct {
+	Token  types.String `tfsdk:"token"`
+	Title  types.String `tfsdk:"title"`
+	Filter types.String `tfsdk:"filter"`
+}
+
+type bundleDashboardModel struct {
+	Token        types.String `tfsdk:"token"`
+	Title        types.String `tfsdk:"title"`
+	DateInterval types.String `tfsdk:"date_interval"`
+}
+
+type bundleCostReportModel struct {
+	Token        types.String `tfsdk:"token"`
+	Title        types.String `tfsdk:"title"`
+	Filter       types.String `tfsdk:"filter"`
+	SegmentToken types.String `tfsdk:"segment_token"`
+	Groupings    types.String `tfsdk:"groupings"`
+	DateInterval types.String `tfsdk:"date_interval"`
+}
+
+func (r *workspaceBundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_bundle"
+}
+
+func (r *workspaceBundleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an entire CloudPulse workspace topology (the workspace plus any folders, segments, virtual tags, saved filters, dashboards, and cost reports) as a single atomically-created bundle.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_token": schema.StringAttribute{
+				Description: "Unique identifier of the workspace created by this bundle. Also the ID to pass to `terraform import`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the workspace.",
+				Required:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the account's default workspace. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the bundle was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"folders": schema.ListNestedBlock{
+				Description: "Folders to create in this workspace.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Description: "Unique identifier of the folder.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "Display title of the folder.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"segments": schema.ListNestedBlock{
+				Description: "Segments to create in this workspace.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Description: "Unique identifier of the segment.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "Display title of the segment.",
+							Required:    true,
+						},
+						"filter": schema.StringAttribute{
+							Description: "CloudPulse filter expression that selects costs into this segment.",
+							Optional:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Evaluation priority relative to other segments; lower values are evaluated first. Defaults to 0.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+						},
+					},
+				},
+			},
+			"virtual_tags": schema.ListNestedBlock{
+				Description: "Virtual tags to create in this workspace.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Description: "Unique identifier of the virtual tag.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"key": schema.StringAttribute{
+							Description: "Tag key, e.g. \"team\".",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Longer description of what this virtual tag represents.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"saved_filters": schema.ListNestedBlock{
+				Description: "Saved filters to create in this workspace.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Description: "Unique identifier of the saved filter.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "Display title of the saved filter.",
+							Required:    true,
+						},
+						"filter": schema.StringAttribute{
+							Description: "CloudPulse filter expression saved under this title.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"dashboards": schema.ListNestedBlock{
+				Description: "Dashboards to create in this workspace.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Description: "Unique identifier of the dashboard.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "Display title of the dashboard.",
+							Required:    true,
+						},
+						"date_interval": schema.StringAttribute{
+							Description: "Default date range for the dashboard, e.g. \"last_30_days\".",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"cost_reports": schema.ListNestedBlock{
+				Description: "Cost reports to create in this workspace.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Description: "Unique identifier of the cost report.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Description: "Display title of the cost report.",
+							Required:    true,
+						},
+						"filter": schema.StringAttribute{
+							Description: "CloudPulse filter expression, e.g. \"costs.service = 'Amazon EC2'\". Conflicts with segment_token.",
+							Optional:    true,
+						},
+						"segment_token": schema.StringAttribute{
+							Description: "Token of a cloudpulse_segment to scope this report to, instead of an ad hoc filter. Conflicts with filter.",
+							Optional:    true,
+						},
+						"groupings": schema.StringAttribute{
+							Description: "Comma-separated dimensions to group by, e.g. \"service\".",
+							Required:    true,
+						},
+						"date_interval": schema.StringAttribute{
+							Description: "Date range for the report, e.g. \"last_30_days\".",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceBundleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("cost_reports").AtAnyListIndex().AtName("filter"),
+			path.MatchRoot("cost_reports").AtAnyListIndex().AtName("segment_token"),
+		),
+	}
+}
+
+func (r *workspaceBundleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *workspaceBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundle, _, err := r.client.Bundles.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), workspaceBundleRequestBody(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Workspace Bundle", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, workspaceBundleModelFromAPI(bundle))...)
+}
+
+// Read reconstructs the bundle by listing every child via the existing
+// per-resource services, scoped to this workspace. This is also how
+// `terraform import cloudpulse_workspace_bundle.foo <workspace_token>`
+// recovers a bundle's full state: ImportState only seeds workspace_token,
+// and the framework calls Read immediately afterward.
+func (r *workspaceBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := state.WorkspaceToken.ValueString()
+	workspace, _, err := r.client.Workspaces.Get(ctx, token)
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle", err.Error())
+		return
+	}
+
+	folders, _, err := r.client.Folders.List(ctx, &cloudpulse.ListParams{WorkspaceToken: token})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle Folders", err.Error())
+		return
+	}
+	segments, _, err := r.client.Segments.List(ctx, &cloudpulse.ListParams{WorkspaceToken: token})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle Segments", err.Error())
+		return
+	}
+	virtualTags, _, err := r.client.VirtualTags.List(ctx, &cloudpulse.ListParams{WorkspaceToken: token})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle Virtual Tags", err.Error())
+		return
+	}
+	savedFilters, _, err := r.client.SavedFilters.List(ctx, &cloudpulse.ListParams{WorkspaceToken: token})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle Saved Filters", err.Error())
+		return
+	}
+	dashboards, _, err := r.client.Dashboards.List(ctx, &cloudpulse.ListParams{WorkspaceToken: token})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle Dashboards", err.Error())
+		return
+	}
+	costReports, _, err := r.client.CostReports.List(ctx, &cloudpulse.ListParams{WorkspaceToken: token})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspace Bundle Cost Reports", err.Error())
+		return
+	}
+
+	model := workspaceBundleResourceModel{
+		WorkspaceToken: types.StringValue(workspace.Token),
+		Name:           types.StringValue(workspace.Name),
+		IsDefault:      types.BoolValue(workspace.IsDefault),
+		CreatedAt:      types.StringValue(workspace.CreatedAt),
+	}
+	for _, f := range folders.Folders {
+		model.Folders = append(model.Folders, bundleFolderModel{
+			Token: types.StringValue(f.Token),
+			Title: types.StringValue(f.Title),
+		})
+	}
+	for _, s := range segments.Segments {
+		model.Segments = append(model.Segments, bundleSegmentModel{
+			Token:    types.StringValue(s.Token),
+			Title:    types.StringValue(s.Title),
+			Filter:   types.StringValue(s.Filter),
+			Priority: types.Int64Value(int64(s.Priority)),
+		})
+	}
+	for _, v := range virtualTags.VirtualTags {
+		model.VirtualTags = append(model.VirtualTags, bundleVirtualTagModel{
+			Token:       types.StringValue(v.Token),
+			Key:         types.StringValue(v.Key),
+			Description: types.StringValue(v.Description),
+		})
+	}
+	for _, sf := range savedFilters.SavedFilters {
+		model.SavedFilters = append(model.SavedFilters, bundleSavedFilterModel{
+			Token:  types.StringValue(sf.Token),
+			Title:  types.StringValue(sf.Title),
+			Filter: types.StringValue(sf.Filter),
+		})
+	}
+	for _, d := range dashboards.Dashboards {
+		model.Dashboards = append(model.Dashboards, bundleDashboardModel{
+			Token:        types.StringValue(d.Token),
+			Title:        types.StringValue(d.Title),
+			DateInterval: types.StringValue(d.DateInterval),
+		})
+	}
+	for _, c := range costReports.CostReports {
+		model.CostReports = append(model.CostReports, bundleCostReportModel{
+			Token:        types.StringValue(c.Token),
+			Title:        types.StringValue(c.Title),
+			Filter:       types.StringValue(c.Filter),
+			SegmentToken: types.StringValue(c.SegmentToken),
+			Groupings:    types.StringValue(c.Groupings),
+			DateInterval: types.StringValue(c.DateInterval),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update reconciles each child collection against the plan by matching
+// prior and next entries on their identifying attribute (title, or key
+// for virtual tags) rather than list position, so inserting, removing,
+// or reordering an entry doesn't shift indices and mutate the wrong
+// child. Matched entries are PATCHed only if their other fields changed,
+// entries with no match in prior are created, and prior entries with no
+// match in next are deleted. This avoids recreating the whole bundle for
+// a one-field change to a single child.
+func (r *workspaceBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state workspaceBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := state.WorkspaceToken.ValueString()
+
+	if plan.Name.ValueString() != state.Name.ValueString() || plan.IsDefault.ValueBool() != state.IsDefault.ValueBool() {
+		if _, _, err := r.client.Workspaces.Update(ctx, token, map[string]interface{}{
+			"name":       plan.Name.ValueString(),
+			"is_default": plan.IsDefault.ValueBool(),
+		}); err != nil {
+			resp.Diagnostics.AddError("Error Updating Workspace Bundle", err.Error())
+			return
+		}
+	}
+
+	plan.Folders = reconcileBundleFolders(ctx, r.client, &resp.Diagnostics, token, state.Folders, plan.Folders)
+	plan.Segments = reconcileBundleSegments(ctx, r.client, &resp.Diagnostics, token, state.Segments, plan.Segments)
+	plan.VirtualTags = reconcileBundleVirtualTags(ctx, r.client, &resp.Diagnostics, token, state.VirtualTags, plan.VirtualTags)
+	plan.SavedFilters = reconcileBundleSavedFilters(ctx, r.client, &resp.Diagnostics, token, state.SavedFilters, plan.SavedFilters)
+	plan.Dashboards = reconcileBundleDashboards(ctx, r.client, &resp.Diagnostics, token, state.Dashboards, plan.Dashboards)
+	plan.CostReports = reconcileBundleCostReports(ctx, r.client, &resp.Diagnostics, token, state.CostReports, plan.CostReports)
+
+	plan.WorkspaceToken = state.WorkspaceToken
+	plan.CreatedAt = state.CreatedAt
+
+	// Reconcile helpers return whatever they actually reconciled, live
+	// tokens included, even when one fails partway through a collection.
+	// Persist that partial result before bailing out so Terraform keeps
+	// tracking resources it just created or updated server-side instead
+	// of losing them on a reconcile error.
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceBundleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Bundles.Delete(ctx, state.WorkspaceToken.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Workspace Bundle", err.Error())
+	}
+}
+
+func (r *workspaceBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("workspace_token"), req, resp)
+}
+
+func workspaceBundleRequestBody(plan workspaceBundleResourceModel) map[string]interface{} {
+	folders := make([]map[string]interface{}, 0, len(plan.Folders))
+	for _, f := range plan.Folders {
+		folders = append(folders, map[string]interface{}{"title": f.Title.ValueString()})
+	}
+	segments := make([]map[string]interface{}, 0, len(plan.Segments))
+	for _, s := range plan.Segments {
+		segments = append(segments, map[string]interface{}{
+			"title":    s.Title.ValueString(),
+			"filter":   s.Filter.ValueString(),
+			"priority": s.Priority.ValueInt64(),
+		})
+	}
+	virtualTags := make([]map[string]interface{}, 0, len(plan.VirtualTags))
+	for _, v := range plan.VirtualTags {
+		virtualTags = append(virtualTags, map[string]interface{}{
+			"key":         v.Key.ValueString(),
+			"description": v.Description.ValueString(),
+		})
+	}
+	savedFilters := make([]map[string]interface{}, 0, len(plan.SavedFilters))
+	for _, sf := range plan.SavedFilters {
+		savedFilters = append(savedFilters, map[string]interface{}{
+			"title":  sf.Title.ValueString(),
+			"filter": sf.Filter.ValueString(),
+		})
+	}
+	dashboards := make([]map[string]interface{}, 0, len(plan.Dashboards))
+	for _, d := range plan.Dashboards {
+		dashboards = append(dashboards, map[string]interface{}{
+			"title":         d.Title.ValueString(),
+			"date_interval": d.DateInterval.ValueString(),
+		})
+	}
+	costReports := make([]map[string]interface{}, 0, len(plan.CostReports))
+	for _, c := range plan.CostReports {
+		costReports = append(costReports, map[string]interface{}{
+			"title":         c.Title.ValueString(),
+			"filter":        c.Filter.ValueString(),
+			"segment_token": c.SegmentToken.ValueString(),
+			"groupings":     c.Groupings.ValueString(),
+			"date_interval": c.DateInterval.ValueString(),
+		})
+	}
+
+	return map[string]interface{}{
+		"workspace": map[string]interface{}{
+			"name":       plan.Name.ValueString(),
+			"is_default": plan.IsDefault.ValueBool(),
+		},
+		"folders":       folders,
+		"segments":      segments,
+		"virtual_tags":  virtualTags,
+		"saved_filters": savedFilters,
+		"dashboards":    dashboards,
+		"cost_reports":  costReports,
+	}
+}
+
+func workspaceBundleModelFromAPI(b *cloudpulse.WorkspaceBundle) workspaceBundleResourceModel {
+	model := workspaceBundleResourceModel{
+		WorkspaceToken: types.StringValue(b.WorkspaceToken),
+		Name:           types.StringValue(b.Workspace.Name),
+		IsDefault:      types.BoolValue(b.Workspace.IsDefault),
+		CreatedAt:      types.StringValue(b.CreatedAt),
+	}
+	for _, f := range b.Folders {
+		model.Folders = append(model.Folders, bundleFolderModel{
+			Token: types.StringValue(f.Token),
+			Title: types.StringValue(f.Title),
+		})
+	}
+	for _, s := range b.Segments {
+		model.Segments = append(model.Segments, bundleSegmentModel{
+			Token:    types.StringValue(s.Token),
+			Title:    types.StringValue(s.Title),
+			Filter:   types.StringValue(s.Filter),
+			Priority: types.Int64Value(int64(s.Priority)),
+		})
+	}
+	for _, v := range b.VirtualTags {
+		model.VirtualTags = append(model.VirtualTags, bundleVirtualTagModel{
+			Token:       types.StringValue(v.Token),
+			Key:         types.StringValue(v.Key),
+			Description: types.StringValue(v.Description),
+		})
+	}
+	for _, sf := range b.SavedFilters {
+		model.SavedFilters = append(model.SavedFilters, bundleSavedFilterModel{
+			Token:  types.StringValue(sf.Token),
+			Title:  types.StringValue(sf.Title),
+			Filter: types.StringValue(sf.Filter),
+		})
+	}
+	for _, d := range b.Dashboards {
+		model.Dashboards = append(model.Dashboards, bundleDashboardModel{
+			Token:        types.StringValue(d.Token),
+			Title:        types.StringValue(d.Title),
+			DateInterval: types.StringValue(d.DateInterval),
+		})
+	}
+	for _, c := range b.CostReports {
+		model.CostReports = append(model.CostReports, bundleCostReportModel{
+			Token:        types.StringValue(c.Token),
+			Title:        types.StringValue(c.Title),
+			Filter:       types.StringValue(c.Filter),
+			SegmentToken: types.StringValue(c.SegmentToken),
+			Groupings:    types.StringValue(c.Groupings),
+			DateInterval: types.StringValue(c.DateInterval),
+		})
+	}
+	return model
+}
+
+func reconcileBundleFolders(ctx context.Context, client *cloudpulse.Client, diags *diag.Diagnostics, workspaceToken string, prior, next []bundleFolderModel) []bundleFolderModel {
+	priorByTitle := make(map[string]bundleFolderModel, len(prior))
+	for _, p := range prior {
+		priorByTitle[p.Title.ValueString()] = p
+	}
+
+	result := make([]bundleFolderModel, 0, len(next))
+	for _, n := range next {
+		if p, ok := priorByTitle[n.Title.ValueString()]; ok {
+			delete(priorByTitle, n.Title.ValueString())
+			n.Token = p.Token
+		} else {
+			f, _, err := client.Folders.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+				"workspace_token": workspaceToken,
+				"title":           n.Title.ValueString(),
+			})
+			if err != nil {
+				diags.AddError("Error Creating Bundle Folder", err.Error())
+				return result
+			}
+			n.Token = types.StringValue(f.Token)
+		}
+		result = append(result, n)
+	}
+	for title, p := range priorByTitle {
+		if _, _, err := client.Folders.Delete(ctx, p.Token.ValueString()); err != nil {
+			diags.AddError("Error Deleting Bundle Folder", err.Error())
+			// Keep every prior entry not yet confirmed deleted (this one
+			// included) in the result so it stays tracked in state rather
+			// than being forgotten while still live remotely.
+			delete(priorByTitle, title)
+			result = append(result, p)
+			for _, remaining := range priorByTitle {
+				result = append(result, remaining)
+			}
+			return result
+		}
+	}
+	return result
+}
+
+func reconcileBundleSegments(ctx context.Context, client *cloudpulse.Client, diags *diag.Diagnostics, workspaceToken string, prior, next []bundleSegmentModel) []bundleSegmentModel {
+	priorByTitle := make(map[string]bundleSegmentModel, len(prior))
+	for _, p := range prior {
+		priorByTitle[p.Title.ValueString()] = p
+	}
+
+	result := make([]bundleSegmentModel, 0, len(next))
+	for _, n := range next {
+		if p, ok := priorByTitle[n.Title.ValueString()]; ok {
+			delete(priorByTitle, n.Title.ValueString())
+			if n.Filter.ValueString() != p.Filter.ValueString() || n.Priority.ValueInt64() != p.Priority.ValueInt64() {
+				s, _, err := client.Segments.Update(ctx, p.Token.ValueString(), map[string]interface{}{
+					"title":    n.Title.ValueString(),
+					"filter":   n.Filter.ValueString(),
+					"priority": n.Priority.ValueInt64(),
+				})
+				if err != nil {
+					diags.AddError("Error Updating Bundle Segment", err.Error())
+					// The update failed, so the entry is unchanged
+					// remotely: keep tracking it as p, not the
+					// unapplied n, so it isn't dropped from state.
+					return append(result, p)
+				}
+				n.Token = types.StringValue(s.Token)
+			} else {
+				n.Token = p.Token
+			}
+		} else {
+			s, _, err := client.Segments.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+				"workspace_token": workspaceToken,
+				"title":           n.Title.ValueString(),
+				"filter":          n.Filter.ValueString(),
+				"priority":        n.Priority.ValueInt64(),
+			})
+			if err != nil {
+				diags.AddError("Error Creating Bundle Segment", err.Error())
+				return result
+			}
+			n.Token = types.StringValue(s.Token)
+		}
+		result = append(result, n)
+	}
+	for title, p := range priorByTitle {
+		if _, _, err := client.Segments.Delete(ctx, p.Token.ValueString()); err != nil {
+			diags.AddError("Error Deleting Bundle Segment", err.Error())
+			delete(priorByTitle, title)
+			result = append(result, p)
+			for _, remaining := range priorByTitle {
+				result = append(result, remaining)
+			}
+			return result
+		}
+	}
+	return result
+}
+
+func reconcileBundleVirtualTags(ctx context.Context, client *cloudpulse.Client, diags *diag.Diagnostics, workspaceToken string, prior, next []bundleVirtualTagModel) []bundleVirtualTagModel {
+	priorByKey := make(map[string]bundleVirtualTagModel, len(prior))
+	for _, p := range prior {
+		priorByKey[p.Key.ValueString()] = p
+	}
+
+	result := make([]bundleVirtualTagModel, 0, len(next))
+	for _, n := range next {
+		if p, ok := priorByKey[n.Key.ValueString()]; ok {
+			delete(priorByKey, n.Key.ValueString())
+			if n.Description.ValueString() != p.Description.ValueString() {
+				v, _, err := client.VirtualTags.Update(ctx, p.Token.ValueString(), map[string]interface{}{
+					"key":         n.Key.ValueString(),
+					"description": n.Description.ValueString(),
+				})
+				if err != nil {
+					diags.AddError("Error Updating Bundle Virtual Tag", err.Error())
+					// The update failed, so the entry is unchanged
+					// remotely: keep tracking it as p, not the
+					// unapplied n, so it isn't dropped from state.
+					return append(result, p)
+				}
+				n.Token = types.StringValue(v.Token)
+			} else {
+				n.Token = p.Token
+			}
+		} else {
+			v, _, err := client.VirtualTags.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+				"workspace_token": workspaceToken,
+				"key":             n.Key.ValueString(),
+				"description":     n.Description.ValueString(),
+			})
+			if err != nil {
+				diags.AddError("Error Creating Bundle Virtual Tag", err.Error())
+				return result
+			}
+			n.Token = types.StringValue(v.Token)
+		}
+		result = append(result, n)
+	}
+	for key, p := range priorByKey {
+		if _, _, err := client.VirtualTags.Delete(ctx, p.Token.ValueString()); err != nil {
+			diags.AddError("Error Deleting Bundle Virtual Tag", err.Error())
+			delete(priorByKey, key)
+			result = append(result, p)
+			for _, remaining := range priorByKey {
+				result = append(result, remaining)
+			}
+			return result
+		}
+	}
+	return result
+}
+
+func reconcileBundleSavedFilters(ctx context.Context, client *cloudpulse.Client, diags *diag.Diagnostics, workspaceToken string, prior, next []bundleSavedFilterModel) []bundleSavedFilterModel {
+	priorByTitle := make(map[string]bundleSavedFilterModel, len(prior))
+	for _, p := range prior {
+		priorByTitle[p.Title.ValueString()] = p
+	}
+
+	result := make([]bundleSavedFilterModel, 0, len(next))
+	for _, n := range next {
+		if p, ok := priorByTitle[n.Title.ValueString()]; ok {
+			delete(priorByTitle, n.Title.ValueString())
+			if n.Filter.ValueString() != p.Filter.ValueString() {
+				sf, _, err := client.SavedFilters.Update(ctx, p.Token.ValueString(), map[string]interface{}{
+					"title":  n.Title.ValueString(),
+					"filter": n.Filter.ValueString(),
+				})
+				if err != nil {
+					diags.AddError("Error Updating Bundle Saved Filter", err.Error())
+					// The update failed, so the entry is unchanged
+					// remotely: keep tracking it as p, not the
+					// unapplied n, so it isn't dropped from state.
+					return append(result, p)
+				}
+				n.Token = types.StringValue(sf.Token)
+			} else {
+				n.Token = p.Token
+			}
+		} else {
+			sf, _, err := client.SavedFilters.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+				"workspace_token": workspaceToken,
+				"title":           n.Title.ValueString(),
+				"filter":          n.Filter.ValueString(),
+			})
+			if err != nil {
+				diags.AddError("Error Creating Bundle Saved Filter", err.Error())
+				return result
+			}
+			n.Token = types.StringValue(sf.Token)
+		}
+		result = append(result, n)
+	}
+	for title, p := range priorByTitle {
+		if _, _, err := client.SavedFilters.Delete(ctx, p.Token.ValueString()); err != nil {
+			diags.AddError("Error Deleting Bundle Saved Filter", err.Error())
+			delete(priorByTitle, title)
+			result = append(result, p)
+			for _, remaining := range priorByTitle {
+				result = append(result, remaining)
+			}
+			return result
+		}
+	}
+	return result
+}
+
+func reconcileBundleDashboards(ctx context.Context, client *cloudpulse.Client, diags *diag.Diagnostics, workspaceToken string, prior, next []bundleDashboardModel) []bundleDashboardModel {
+	priorByTitle := make(map[string]bundleDashboardModel, len(prior))
+	for _, p := range prior {
+		priorByTitle[p.Title.ValueString()] = p
+	}
+
+	result := make([]bundleDashboardModel, 0, len(next))
+	for _, n := range next {
+		if p, ok := priorByTitle[n.Title.ValueString()]; ok {
+			delete(priorByTitle, n.Title.ValueString())
+			if n.DateInterval.ValueString() != p.DateInterval.ValueString() {
+				d, _, err := client.Dashboards.Update(ctx, p.Token.ValueString(), map[string]interface{}{
+					"title":         n.Title.ValueString(),
+					"date_interval": n.DateInterval.ValueString(),
+				})
+				if err != nil {
+					diags.AddError("Error Updating Bundle Dashboard", err.Error())
+					// The update failed, so the entry is unchanged
+					// remotely: keep tracking it as p, not the
+					// unapplied n, so it isn't dropped from state.
+					return append(result, p)
+				}
+				n.Token = types.StringValue(d.Token)
+			} else {
+				n.Token = p.Token
+			}
+		} else {
+			d, _, err := client.Dashboards.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+				"workspace_token": workspaceToken,
+				"title":           n.Title.ValueString(),
+				"date_interval":   n.DateInterval.ValueString(),
+			})
+			if err != nil {
+				diags.AddError("Error Creating Bundle Dashboard", err.Error())
+				return result
+			}
+			n.Token = types.StringValue(d.Token)
+		}
+		result = append(result, n)
+	}
+	for title, p := range priorByTitle {
+		if _, _, err := client.Dashboards.Delete(ctx, p.Token.ValueString()); err != nil {
+			diags.AddError("Error Deleting Bundle Dashboard", err.Error())
+			delete(priorByTitle, title)
+			result = append(result, p)
+			for _, remaining := range priorByTitle {
+				result = append(result, remaining)
+			}
+			return result
+		}
+	}
+	return result
+}
+
+func reconcileBundleCostReports(ctx context.Context, client *cloudpulse.Client, diags *diag.Diagnostics, workspaceToken string, prior, next []bundleCostReportModel) []bundleCostReportModel {
+	priorByTitle := make(map[string]bundleCostReportModel, len(prior))
+	for _, p := range prior {
+		priorByTitle[p.Title.ValueString()] = p
+	}
+
+	result := make([]bundleCostReportModel, 0, len(next))
+	for _, n := range next {
+		if p, ok := priorByTitle[n.Title.ValueString()]; ok {
+			delete(priorByTitle, n.Title.ValueString())
+			if n.Filter.ValueString() != p.Filter.ValueString() ||
+				n.SegmentToken.ValueString() != p.SegmentToken.ValueString() ||
+				n.Groupings.ValueString() != p.Groupings.ValueString() ||
+				n.DateInterval.ValueString() != p.DateInterval.ValueString() {
+				c, _, err := client.CostReports.Update(ctx, p.Token.ValueString(), map[string]interface{}{
+					"title":         n.Title.ValueString(),
+					"filter":        n.Filter.ValueString(),
+					"segment_token": n.SegmentToken.ValueString(),
+					"groupings":     n.Groupings.ValueString(),
+					"date_interval": n.DateInterval.ValueString(),
+				})
+				if err != nil {
+					diags.AddError("Error Updating Bundle Cost Report", err.Error())
+					// The update failed, so the entry is unchanged
+					// remotely: keep tracking it as p, not the
+					// unapplied n, so it isn't dropped from state.
+					return append(result, p)
+				}
+				n.Token = types.StringValue(c.Token)
+			} else {
+				n.Token = p.Token
+			}
+		} else {
+			c, _, err := client.CostReports.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+				"workspace_token": workspaceToken,
+				"title":           n.Title.ValueString(),
+				"filter":          n.Filter.ValueString(),
+				"segment_token":   n.SegmentToken.ValueString(),
+				"groupings":       n.Groupings.ValueString(),
+				"date_interval":   n.DateInterval.ValueString(),
+			})
+			if err != nil {
+				diags.AddError("Error Creating Bundle Cost Report", err.Error())
+				return result
+			}
+			n.Token = types.StringValue(c.Token)
+		}
+		result = append(result, n)
+	}
+	for title, p := range priorByTitle {
+		if _, _, err := client.CostReports.Delete(ctx, p.Token.ValueString()); err != nil {
+			diags.AddError("Error Deleting Bundle Cost Report", err.Error())
+			delete(priorByTitle, title)
+			result = append(result, p)
+			for _, remaining := range priorByTitle {
+				result = append(result, remaining)
+			}
+			return result
+		}
+	}
+	return result
+}
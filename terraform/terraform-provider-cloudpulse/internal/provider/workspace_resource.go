@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ resource.Resource                = &workspaceResource{}
+	_ resource.ResourceWithConfigure   = &workspaceResource{}
+	_ resource.ResourceWithImportState = &workspaceResource{}
+)
+
+func NewWorkspaceResource() resource.Resource {
+	return &workspaceResource{}
+}
+
+type workspaceResource struct {
+	client *cloudpulse.Client
+}
+
+type workspaceResourceModel struct {
+	Token     types.String `tfsdk:"token"`
+	Name      types.String `tfsdk:"name"`
+	IsDefault types.Bool   `tfsdk:"is_default"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (r *workspaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a CloudPulse workspace, the top-level container for folders, cost reports, and other resources.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "Unique identifier of the workspace.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the workspace.",
+				Required:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this workspace is the account's default workspace.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the workspace was created, in RFC3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *workspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan workspaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, _, err := r.client.Workspaces.Create(cloudpulse.WithIdempotencyKey(ctx, cloudpulse.NewIdempotencyKey()), map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Workspace", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, workspaceModelFromAPI(workspace))...)
+}
+
+func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state workspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, _, err := r.client.Workspaces.Get(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Workspace", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, workspaceModelFromAPI(workspace))...)
+}
+
+func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state workspaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace, _, err := r.client.Workspaces.Update(ctx, state.Token.ValueString(), map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Workspace", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, workspaceModelFromAPI(workspace))...)
+}
+
+func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, err := r.client.Workspaces.Delete(ctx, state.Token.ValueString())
+	if err != nil {
+		var apiErr *cloudpulse.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Workspace", err.Error())
+	}
+}
+
+func (r *workspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("token"), req, resp)
+}
+
+func workspaceModelFromAPI(w *cloudpulse.Workspace) workspaceResourceModel {
+	return workspaceResourceModel{
+		Token:     types.StringValue(w.Token),
+		Name:      types.StringValue(w.Name),
+		IsDefault: types.BoolValue(w.IsDefault),
+		CreatedAt: types.StringValue(w.CreatedAt),
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/abhineetsood21/cloudpulse-go/cloudpulse"
+)
+
+var (
+	_ datasource.DataSource              = &workspacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspacesDataSource{}
+)
+
+func NewWorkspacesDataSource() datasource.DataSource {
+	return &workspacesDataSource{}
+}
+
+type workspacesDataSource struct {
+	client *cloudpulse.Client
+}
+
+type workspacesDataSourceModel struct {
+	Workspaces []workspaceResourceModel `tfsdk:"workspaces"`
+}
+
+func (d *workspacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspaces"
+}
+
+func (d *workspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every CloudPulse workspace visible to the configured API token.",
+		Attributes: map[string]schema.Attribute{
+			"workspaces": schema.ListNestedAttribute{
+				Description: "The workspaces found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"token":      schema.StringAttribute{Computed: true},
+						"name":       schema.StringAttribute{Computed: true},
+						"is_default": schema.BoolAttribute{Computed: true},
+						"created_at": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *workspacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *workspacesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	workspaces, err := d.client.Workspaces.ListWorkspaces(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Workspaces", err.Error())
+		return
+	}
+
+	model := workspacesDataSourceModel{Workspaces: make([]workspaceResourceModel, 0, len(workspaces))}
+	for _, w := range workspaces {
+		model.Workspaces = append(model.Workspaces, workspaceModelFromAPI(&w))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}